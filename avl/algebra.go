@@ -0,0 +1,297 @@
+// algebra.go
+
+package avl
+
+// Set algebra using join based algorithms, each O(m log(n/m + 1)) for trees of m <= n items
+//  the receiver gets the result, and like heap Meld() the other tree's nodes are moved over, leaving it empty
+//  both trees must use the same comparison and duplicate policy, items equal by it are the same member
+//  copies of a member, as nodes with AllowDuplicates or counts with CountDuplicates, are matched one for one as a multiset
+//  the receiver's copies are kept first, latest copies of a tree are kept over earlier ones, as Remove() takes the earliest
+// Follows "Just Join for Parallel Ordered Sets" by Blelloch, Ferizovic, Sun
+
+// Union adds items of other not already in t, keeping the most copies of either tree
+func (t *Tree[T]) Union(other *Tree[T]) {
+	if other == t {
+		return
+	}
+	t.root = t.combine(t.take(), other.take(), unionCopies)
+}
+
+// Intersect removes items of t not in other, keeping the fewest copies of either tree
+func (t *Tree[T]) Intersect(other *Tree[T]) {
+	if other == t {
+		return
+	}
+	t.root = t.combine(t.take(), other.take(), intersectCopies)
+}
+
+// Difference removes items of t that are in other, a copy for each copy in other
+func (t *Tree[T]) Difference(other *Tree[T]) {
+	if other == t {
+		t.root = nil
+		return
+	}
+	t.root = t.combine(t.take(), other.take(), differenceCopies)
+}
+
+// SymmetricDifference keeps items in either t or other, but not both, as many copies as one tree has over the other
+func (t *Tree[T]) SymmetricDifference(other *Tree[T]) {
+	if other == t {
+		t.root = nil
+		return
+	}
+	t.root = t.combine(t.take(), other.take(), symmetricDifferenceCopies)
+}
+
+// Split moves items less than pivot to a new left tree and the rest to a new right tree, leaving t empty
+//  both new trees keep the comparison and duplicate policy of t
+func (t *Tree[T]) Split(pivot T) (*Tree[T], *Tree[T]) {
+	left, right := t.emptyCopy(), t.emptyCopy()
+	left.root, right.root = t.splitBelow(t.take(), pivot)
+	return left, right
+}
+
+// Join replaces contents of t with items of left followed by those of right, leaving both empty
+//  all items of left must not be greater than those of right, else trees are left unchanged and ErrUnsorted returned
+//  a t without comparisons, such as a zero value Tree, takes comparison, duplicate policy and codec of left, or right if left has none
+func (t *Tree[T]) Join(left, right *Tree[T]) error {
+	if t.compare == nil {
+		from := left
+		if from.compare == nil {
+			from = right
+		}
+		*t = *from.emptyCopy()
+	}
+	if last, first := left.GetTreeMaximum(), right.GetTreeMinimum(); last != nil && first != nil && t.compare(last.value, first.value) > 0 {
+		return ErrUnsorted
+	}
+	l, r := left.take(), right.take()
+	t.root = join2(l, r)
+	return nil
+}
+
+// take detaches and returns root, leaving tree empty
+func (t *Tree[T]) take() *TreeNode[T] {
+	n := t.root
+	t.root = nil
+	return n
+}
+
+// emptyCopy returns an empty tree with the same comparison, duplicate policy and codec
+func (t *Tree[T]) emptyCopy() *Tree[T] {
+	return &Tree[T]{
+		lesser:     t.lesser,
+		equals:     t.equals,
+		compare:    t.compare,
+		duplicates: t.duplicates,
+		codec:      t.codec,
+	}
+}
+
+// expose detaches node n from its parent and children, returns the children
+func expose[T any](n *TreeNode[T]) (*TreeNode[T], *TreeNode[T]) {
+	l, r := n.left, n.right
+	if l != nil {
+		l.parent = nil
+	}
+	if r != nil {
+		r.parent = nil
+	}
+	n.left, n.right, n.parent = nil, nil, nil
+	return l, r
+}
+
+// link makes detached node k the root of branches l and r, returns k
+func link[T any](l, k, r *TreeNode[T]) *TreeNode[T] {
+	k.left, k.right, k.parent = l, r, nil
+	if l != nil {
+		l.parent = k
+	}
+	if r != nil {
+		r.parent = k
+	}
+	FixHeight(k)
+	FixSize(k)
+	return k
+}
+
+// join links l, k and r into a balanced branch, where l < k < r
+func join[T any](l, k, r *TreeNode[T]) *TreeNode[T] {
+	if GetHeight(l) > GetHeight(r)+1 {
+		return joinRight(l, k, r)
+	}
+	if GetHeight(r) > GetHeight(l)+1 {
+		return joinLeft(l, k, r)
+	}
+	return link(l, k, r)
+}
+
+// joinRight joins down the right spine of l, which is taller than r
+func joinRight[T any](l, k, r *TreeNode[T]) *TreeNode[T] {
+	ll, c := expose(l)
+	if GetHeight(c) <= GetHeight(r)+1 {
+		t := link(c, k, r)
+		if GetHeight(t) <= GetHeight(ll)+1 {
+			return link(ll, l, t)
+		}
+		return LeftRotate(link(ll, l, RightRotate(t)))
+	}
+	t := joinRight(c, k, r)
+	if GetHeight(t) <= GetHeight(ll)+1 {
+		return link(ll, l, t)
+	}
+	return LeftRotate(link(ll, l, t))
+}
+
+// joinLeft joins down the left spine of r, which is taller than l
+func joinLeft[T any](l, k, r *TreeNode[T]) *TreeNode[T] {
+	c, rr := expose(r)
+	if GetHeight(c) <= GetHeight(l)+1 {
+		t := link(l, k, c)
+		if GetHeight(t) <= GetHeight(rr)+1 {
+			return link(t, r, rr)
+		}
+		return RightRotate(link(LeftRotate(t), r, rr))
+	}
+	t := joinLeft(l, k, c)
+	if GetHeight(t) <= GetHeight(rr)+1 {
+		return link(t, r, rr)
+	}
+	return RightRotate(link(t, r, rr))
+}
+
+// join2 joins l and r without a middle node, where l < r
+func join2[T any](l, r *TreeNode[T]) *TreeNode[T] {
+	if l == nil {
+		return r
+	}
+	rest, last := splitLast(l)
+	return join(rest, last, r)
+}
+
+// splitLast detaches highest node of branch n, returns the rest and the node
+func splitLast[T any](n *TreeNode[T]) (*TreeNode[T], *TreeNode[T]) {
+	l, r := expose(n)
+	if r == nil {
+		return l, n
+	}
+	rest, last := splitLast(r)
+	return join(l, n, rest), last
+}
+
+// splitBelow divides branch n into items less than k and items greater than or equal to k
+func (t *Tree[T]) splitBelow(n *TreeNode[T], k T) (*TreeNode[T], *TreeNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	l, r := expose(n)
+	if t.compare(n.value, k) < 0 {
+		rl, rr := t.splitBelow(r, k)
+		return join(l, n, rl), rr
+	}
+	ll, lr := t.splitBelow(l, k)
+	return ll, join(lr, n, r)
+}
+
+// splitAbove divides branch n into items less than or equal to k and items greater than k
+func (t *Tree[T]) splitAbove(n *TreeNode[T], k T) (*TreeNode[T], *TreeNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	l, r := expose(n)
+	if t.compare(n.value, k) <= 0 {
+		rl, rr := t.splitAbove(r, k)
+		return join(l, n, rl), rr
+	}
+	ll, lr := t.splitAbove(l, k)
+	return ll, join(lr, n, r)
+}
+
+// split divides branch n into items less than k, all items equal to k, and items greater than k
+func (t *Tree[T]) split(n *TreeNode[T], k T) (*TreeNode[T], *TreeNode[T], *TreeNode[T]) {
+	l, rest := t.splitBelow(n, k)
+	m, r := t.splitAbove(rest, k)
+	return l, m, r
+}
+
+// copies picks how many of na copies of a member in one tree and nb in the other are kept from each
+type copies func(na, nb int) (int, int)
+
+// unionCopies keeps all copies of a, and those of b it has over a
+func unionCopies(na, nb int) (int, int) {
+	return na, max(nb-na, 0)
+}
+
+// intersectCopies keeps copies of a also in b
+func intersectCopies(na, nb int) (int, int) {
+	return min(na, nb), 0
+}
+
+// differenceCopies keeps copies of a over those in b
+func differenceCopies(na, nb int) (int, int) {
+	return max(na-nb, 0), 0
+}
+
+// symmetricDifferenceCopies keeps copies of either over those in the other
+func symmetricDifferenceCopies(na, nb int) (int, int) {
+	return max(na-nb, 0), max(nb-na, 0)
+}
+
+// combine branches a and b member by member, keeping copies of each as picked by keep
+func (t *Tree[T]) combine(a, b *TreeNode[T], keep copies) *TreeNode[T] {
+	if b == nil {
+		if fromA, _ := keep(1, 0); fromA == 0 {
+			return nil
+		}
+		return a
+	}
+	if a == nil {
+		if _, fromB := keep(0, 1); fromB == 0 {
+			return nil
+		}
+		return b
+	}
+	k := b.value
+	bl, bm, br := t.split(b, k)
+	al, am, ar := t.split(a, k)
+	m := t.combineEqual(am, bm, keep)
+	l, r := t.combine(al, bl, keep), t.combine(ar, br, keep)
+	if m == nil {
+		return join2(l, r)
+	} else if m.left == nil && m.right == nil {
+		return join(l, m, r)
+	}
+	return join2(join2(l, m), r)
+}
+
+// combineEqual combines branches a and b, all of whose items are equal, keeping copies as picked by keep
+//  returns the kept copies as a branch, nil if none
+func (t *Tree[T]) combineEqual(a, b *TreeNode[T], keep copies) *TreeNode[T] {
+	na, nb := GetSize(a), GetSize(b) // sizes count copies
+	fromA, fromB := keep(na, nb)
+	if fromA+fromB == 0 {
+		return nil
+	}
+	if t.duplicates == CountDuplicates { // a single node each
+		x := a
+		if fromA == 0 {
+			x = b
+		}
+		x.count = fromA + fromB
+		FixSize(x)
+		return x
+	}
+	as, bs := branchNodes(a, nil), branchNodes(b, nil)
+	nodes := append(as[na-fromA:], bs[nb-fromB:]...)
+	return buildBalanced(nodes, nil)
+}
+
+// branchNodes appends nodes of branch n in order to nodes
+func branchNodes[T any](n *TreeNode[T], nodes []*TreeNode[T]) []*TreeNode[T] {
+	if n == nil {
+		return nodes
+	}
+	nodes = branchNodes(n.left, nodes)
+	nodes = append(nodes, n)
+	return branchNodes(n.right, nodes)
+}
@@ -0,0 +1,264 @@
+// algebra_test.go
+
+package avl
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// randomSet returns a tree and sorted slice of up to n distinct items below limit
+func randomSet(r *rand.Rand, n, limit int) (*Tree[int], []int) {
+	tree := NewOrdered[int]()
+	tree.SetDuplicates(KeepFirstDuplicate)
+	for range n {
+		tree.Insert(r.Intn(limit))
+	}
+	return tree, slices.Collect(tree.All())
+}
+
+func TestSetAlgebra(t *testing.T) {
+	tests := []struct {
+		name  string
+		apply func(a, b *Tree[int])
+		keep  func(inA, inB bool) bool
+	}{
+		{"Union", (*Tree[int]).Union, func(inA, inB bool) bool { return inA || inB }},
+		{"Intersect", (*Tree[int]).Intersect, func(inA, inB bool) bool { return inA && inB }},
+		{"Difference", (*Tree[int]).Difference, func(inA, inB bool) bool { return inA && !inB }},
+		{"SymmetricDifference", (*Tree[int]).SymmetricDifference, func(inA, inB bool) bool { return inA != inB }},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	sizes := [][2]int{{0, 0}, {0, 20}, {20, 0}, {1, 300}, {300, 1}, {50, 50}, {300, 20}, {20, 300}}
+	for _, test := range tests {
+		for _, size := range sizes {
+			a, as := randomSet(r, size[0], 400)
+			b, bs := randomSet(r, size[1], 400)
+			var want []int
+			for n := range 400 {
+				_, inA := slices.BinarySearch(as, n)
+				_, inB := slices.BinarySearch(bs, n)
+				if test.keep(inA, inB) {
+					want = append(want, n)
+				}
+			}
+
+			test.apply(a, b)
+			if err := a.Validate(); err != nil {
+				t.Fatalf("%s %v: %v", test.name, size, err)
+			}
+			if got := slices.Collect(a.All()); !slices.Equal(got, want) {
+				t.Errorf("%s %v: Invalid result, found: %v, expected: %v", test.name, size, got, want)
+			}
+			if b.Len() != 0 {
+				t.Errorf("%s %v: Other tree not emptied", test.name, size)
+			}
+		}
+
+		// with itself
+		a, as := randomSet(r, 50, 100)
+		test.apply(a, a)
+		want := as
+		if !test.keep(true, true) {
+			want = nil
+		}
+		if got := slices.Collect(a.All()); !slices.Equal(got, want) {
+			t.Errorf("%s with itself: Invalid result, found: %v, expected: %v", test.name, got, want)
+		}
+	}
+}
+
+func TestSetAlgebraKeepsReceiverItems(t *testing.T) {
+	type tagged struct {
+		key int
+		tag string
+	}
+	compare := func(a, b tagged) int {
+		return a.key - b.key
+	}
+	a, b := NewCompare(compare), NewCompare(compare)
+	a.FromSorted([]tagged{{1, "a"}, {2, "a"}})
+	b.FromSorted([]tagged{{2, "b"}, {3, "b"}})
+	a.Union(b)
+	want := []tagged{{1, "a"}, {2, "a"}, {3, "b"}}
+	if got := slices.Collect(a.All()); !slices.Equal(got, want) {
+		t.Errorf("Invalid union, found: %v, expected: %v", got, want)
+	}
+}
+
+func TestSetAlgebraDuplicates(t *testing.T) {
+	ops := []struct {
+		name   string
+		apply  func(a, b *Tree[int])
+		copies func(na, nb int) int
+	}{
+		{"Union", (*Tree[int]).Union, func(na, nb int) int { return max(na, nb) }},
+		{"Intersect", (*Tree[int]).Intersect, func(na, nb int) int { return min(na, nb) }},
+		{"Difference", (*Tree[int]).Difference, func(na, nb int) int { return max(na-nb, 0) }},
+		{"SymmetricDifference", (*Tree[int]).SymmetricDifference, func(na, nb int) int { return max(na-nb, nb-na) }},
+	}
+	policies := []Duplicates{AllowDuplicates, RejectDuplicates, ReplaceDuplicates, KeepFirstDuplicate, CountDuplicates}
+
+	r := rand.New(rand.NewSource(1))
+	for _, policy := range policies {
+		for _, op := range ops {
+			for trial := 0; trial < 20; trial++ {
+				a, b := NewOrdered[int](), NewOrdered[int]()
+				a.SetDuplicates(policy)
+				b.SetDuplicates(policy)
+				for range r.Intn(80) {
+					a.Insert(r.Intn(20))
+				}
+				for range r.Intn(80) {
+					b.Insert(r.Intn(20))
+				}
+				var want []int
+				for n := range 20 {
+					for range op.copies(a.Count(n), b.Count(n)) {
+						want = append(want, n)
+					}
+				}
+
+				op.apply(a, b)
+				if err := a.Validate(); err != nil {
+					t.Fatalf("%s %d: %v", op.name, policy, err)
+				}
+				if got := slices.Collect(a.All()); !slices.Equal(got, want) {
+					t.Errorf("%s %d: Invalid result, found: %v, expected: %v", op.name, policy, got, want)
+				}
+				if a.Len() != len(want) || b.Len() != 0 {
+					t.Errorf("%s %d: Invalid length, found: %d, %d", op.name, policy, a.Len(), b.Len())
+				}
+			}
+		}
+	}
+}
+
+func TestSetAlgebraKeepsLatestCopies(t *testing.T) {
+	type tagged struct {
+		key int
+		tag string
+	}
+	compare := func(a, b tagged) int {
+		return a.key - b.key
+	}
+	tests := []struct {
+		givenA []tagged
+		givenB []tagged
+		apply  func(a, b *Tree[tagged])
+
+		wantArray []tagged
+	}{
+		{
+			[]tagged{{1, "a"}, {1, "b"}},
+			[]tagged{{1, "x"}, {1, "y"}, {1, "z"}},
+			(*Tree[tagged]).Union,
+
+			[]tagged{{1, "a"}, {1, "b"}, {1, "z"}},
+		},
+		{
+			[]tagged{{1, "a"}, {1, "b"}, {1, "c"}},
+			[]tagged{{1, "x"}},
+			(*Tree[tagged]).Difference,
+
+			[]tagged{{1, "b"}, {1, "c"}},
+		},
+		{
+			[]tagged{{1, "a"}},
+			[]tagged{{1, "x"}, {1, "y"}, {1, "z"}},
+			(*Tree[tagged]).SymmetricDifference,
+
+			[]tagged{{1, "y"}, {1, "z"}},
+		},
+	}
+	for i, test := range tests {
+		a, b := NewCompare(compare), NewCompare(compare)
+		a.FromSorted(test.givenA)
+		b.FromSorted(test.givenB)
+		test.apply(a, b)
+		if got := slices.Collect(a.All()); !slices.Equal(got, test.wantArray) {
+			t.Errorf("%d: Invalid result, found: %v, expected: %v", i, got, test.wantArray)
+		}
+	}
+}
+
+func TestSplitJoin(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 50; trial++ {
+		tree := NewOrdered[int]()
+		for range r.Intn(200) {
+			tree.Insert(r.Intn(100)) // duplicates allowed
+		}
+		items := slices.Collect(tree.All())
+		pivot := r.Intn(110) - 5
+
+		left, right := tree.Split(pivot)
+		if tree.Len() != 0 {
+			t.Fatalf("%d: Split tree not emptied", trial)
+		}
+		for _, half := range []*Tree[int]{left, right} {
+			if err := half.Validate(); err != nil {
+				t.Fatalf("%d: %v", trial, err)
+			}
+		}
+		i, _ := slices.BinarySearch(items, pivot) // first item not less than pivot
+		if got := slices.Collect(left.All()); !slices.Equal(got, items[:i]) {
+			t.Fatalf("%d: Invalid left of %d, found: %v", trial, pivot, got)
+		}
+		if got := slices.Collect(right.All()); !slices.Equal(got, items[i:]) {
+			t.Fatalf("%d: Invalid right of %d, found: %v", trial, pivot, got)
+		}
+
+		if err := tree.Join(left, right); err != nil {
+			t.Fatalf("%d: %v", trial, err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("%d: %v", trial, err)
+		}
+		if got := slices.Collect(tree.All()); !slices.Equal(got, items) || left.Len() != 0 || right.Len() != 0 {
+			t.Fatalf("%d: Invalid join, found: %v", trial, got)
+		}
+	}
+
+	// unbalanced heights
+	left, right := NewOrdered[int](), NewOrdered[int]()
+	left.Insert(-1)
+	for n := range 1000 {
+		right.Insert(n)
+	}
+	tree := NewOrdered[int]()
+	if err := tree.Join(left, right); err != nil || tree.Validate() != nil || tree.Len() != 1001 {
+		t.Errorf("Invalid join of unbalanced trees")
+	}
+
+	left.Insert(5)
+	right.Insert(1)
+	if err := tree.Join(left, right); err != ErrUnsorted || left.Len() != 1 {
+		t.Errorf("Overlapping trees not reported, found: %v", err)
+	}
+}
+
+func TestJoinZeroValue(t *testing.T) {
+	left, right := NewOrdered[int](), NewOrdered[int]()
+	left.SetDuplicates(KeepFirstDuplicate)
+	left.FromSorted([]int{1, 2, 3})
+	right.FromSorted([]int{4, 5})
+	tree := &Tree[int]{}
+	if err := tree.Join(left, right); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := slices.Collect(tree.All()), []int{1, 2, 3, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("Invalid join, found: %v, expected: %v", got, want)
+	}
+	if tree.GetDuplicates() != KeepFirstDuplicate || !tree.Contains(4) {
+		t.Errorf("Comparison or duplicate policy of left not taken")
+	}
+
+	tree, left = &Tree[int]{}, &Tree[int]{}
+	right.FromSorted([]int{4, 5})
+	if err := tree.Join(left, right); err != nil || !tree.Contains(5) {
+		t.Errorf("Invalid join of empty zero value left, found: %v", err)
+	}
+}
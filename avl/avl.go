@@ -2,47 +2,125 @@
 
 package avl
 
+import (
+	"cmp"
+
+	"github.com/PuppyKhan/jebe/collection"
+)
+
 // Item - the type to be sorted
-type Item interface{}
+type Item = collection.Item
 
-// Node of a binary tree
-type Node struct {
-	value  Item
-	left   *Node
-	right  *Node
-	parent *Node // doubly linked
-	height int   // for AVL property
+// TreeNode of a binary tree holding items of type T
+type TreeNode[T any] struct {
+	value  T
+	left   *TreeNode[T]
+	right  *TreeNode[T]
+	parent *TreeNode[T] // doubly linked
+	count  int          // copies of value, more than 1 only with CountDuplicates
+	height int          // for AVL property
+	size   int          // items in subtree, for order statistics
 }
 
+// Node of a binary tree of Items
+type Node = TreeNode[Item]
+
+// Prioritize - custom comparison for prioritizing tree items of type T
+//  basic sort would need "a < b" ("a > b" for high to low)
+type Prioritize[T any] = collection.Prioritize[T]
+
+// Equivalence - custom comparison for equality of tree items of type T, "a == b"
+type Equivalence[T any] = collection.Equivalence[T]
+
 // PrioritizeTreeItem - custom comparison for prioritizing tree items
 //  basic sort would need "a < b" ("a > b" for high to low)
-type PrioritizeTreeItem func(a, b Item) bool
+type PrioritizeTreeItem = Prioritize[Item]
 
 // EquivalenceTreeItem - custom comparison for equality of tree items, "a == b"
 //  needed for search
-type EquivalenceTreeItem func(a, b Item) bool
+type EquivalenceTreeItem = Equivalence[Item]
+
+// Comparison - custom three-way comparison of tree items of type T
+//  negative for "a < b", 0 for "a == b", positive for "a > b", like cmp.Compare
+type Comparison[T any] = collection.Comparison[T]
+
+// CompareTreeItem - custom three-way comparison of tree items
+//  replaces a PrioritizeTreeItem and EquivalenceTreeItem pair
+type CompareTreeItem = Comparison[Item]
+
+// Codec - converts tree items of type T to and from bytes for MarshalBinary
+type Codec[T any] = collection.Codec[T]
+
+// Tree holds the root of the tree and its comparison functions, for items of type T
+type Tree[T any] struct {
+	root       *TreeNode[T]
+	lesser     Prioritize[T]
+	equals     Equivalence[T]
+	compare    Comparison[T] // used for all ordering, derived if not set directly
+	duplicates Duplicates    // how equal items are inserted
+	codec      Codec[T]      // items for MarshalBinary, GobCodec if not set
+}
+
+// BinaryTree - tree of Items, the interface{} API over the generic Tree
+type BinaryTree = Tree[Item]
+
+// New returns an empty tree using the given comparison funcs
+//  if either is nil, default casts items as type int
+func New[T any](a Prioritize[T], b Equivalence[T]) *Tree[T] {
+	t := &Tree[T]{}
+	t.setComparisons(a, b)
+	return t
+}
+
+// NewCompare returns an empty tree using a three-way comparison func
+//  if c is nil, default casts items as type int
+func NewCompare[T any](c Comparison[T]) *Tree[T] {
+	t := &Tree[T]{}
+	t.setCompare(c)
+	return t
+}
 
-// BinaryTree holds the root of the tree and its comparison functions
-type BinaryTree struct {
-	root   *Node
-	lesser PrioritizeTreeItem
-	equals EquivalenceTreeItem
+// NewOrdered returns an empty tree of ordered items using cmp.Compare
+func NewOrdered[T cmp.Ordered]() *Tree[T] {
+	return NewCompare(cmp.Compare[T])
 }
 
 // MakeNode puts Item into a Node, sets parent, returns pointer
-func MakeNode(val Item, p *Node) *Node {
-	return &Node{
+func MakeNode[T any](val T, p *TreeNode[T]) *TreeNode[T] {
+	return &TreeNode[T]{
 		value:  val,
 		left:   nil,
 		right:  nil,
 		parent: p,
+		count:  1,
 		height: 0,
+		size:   1,
 	}
 }
 
 // Init sets both root node and comparison func
-func (t *BinaryTree) Init(root Item, a PrioritizeTreeItem, b EquivalenceTreeItem) {
+func (t *Tree[T]) Init(root T, a Prioritize[T], b Equivalence[T]) {
 	t.Insert(root)
+	t.setComparisons(a, b)
+}
+
+// InitCompare sets both root node and three-way comparison func
+func (t *Tree[T]) InitCompare(root T, c Comparison[T]) {
+	t.Insert(root)
+	t.setCompare(c)
+}
+
+// setCompare sets three-way comparison func, defaulting nil to ints
+func (t *Tree[T]) setCompare(c Comparison[T]) {
+	if c == nil {
+		t.setComparisons(nil, nil)
+	} else {
+		t.SetCompareTreeItem(c)
+	}
+}
+
+// setComparisons sets both comparison funcs, defaulting nil ones to ints
+func (t *Tree[T]) setComparisons(a Prioritize[T], b Equivalence[T]) {
 	if a == nil {
 		t.SetLTIntPrioritizeTreeItem()
 	} else {
@@ -56,46 +134,92 @@ func (t *BinaryTree) Init(root Item, a PrioritizeTreeItem, b EquivalenceTreeItem
 }
 
 // SetPrioritizeTreeItem - "a < b" or whatever comparison is needed
-func (t *BinaryTree) SetPrioritizeTreeItem(a PrioritizeTreeItem) {
+func (t *Tree[T]) SetPrioritizeTreeItem(a Prioritize[T]) {
 	t.lesser = a
+	t.deriveCompare()
 }
 
 // SetLTIntPrioritizeTreeItem - default "a < b" as ints
-func (t *BinaryTree) SetLTIntPrioritizeTreeItem() {
-	t.lesser = func(a, b Item) bool {
-		return a.(int) < b.(int)
+func (t *Tree[T]) SetLTIntPrioritizeTreeItem() {
+	t.lesser = func(a, b T) bool {
+		return any(a).(int) < any(b).(int)
 	}
+	t.deriveCompare()
 }
 
 // SetEquivalenceTreeItem - "a == b" or whatever comparison is needed
-func (t *BinaryTree) SetEquivalenceTreeItem(b EquivalenceTreeItem) {
+func (t *Tree[T]) SetEquivalenceTreeItem(b Equivalence[T]) {
 	t.equals = b
+	t.deriveCompare()
 }
 
 // SetEqIntEquivalenceTreeItem - default "a == b" as ints
-func (t *BinaryTree) SetEqIntEquivalenceTreeItem() {
-	t.equals = func(a, b Item) bool {
-		return a.(int) == b.(int)
+func (t *Tree[T]) SetEqIntEquivalenceTreeItem() {
+	t.equals = func(a, b T) bool {
+		return any(a).(int) == any(b).(int)
 	}
+	t.deriveCompare()
 }
 
-// Insert a new Item to a tree
-func (t *BinaryTree) Insert(newValue Item) {
-	var y *Node
+// SetCompareTreeItem - three-way comparison such as cmp.Compare or strings.Compare
+//  also derives lesser and equals from it
+func (t *Tree[T]) SetCompareTreeItem(c Comparison[T]) {
+	t.compare = c
+	t.lesser = func(a, b T) bool {
+		return c(a, b) < 0
+	}
+	t.equals = func(a, b T) bool {
+		return c(a, b) == 0
+	}
+}
+
+// deriveCompare adapts lesser and equals into a three-way comparison
+func (t *Tree[T]) deriveCompare() {
+	lesser, equals := t.lesser, t.equals
+	if equals == nil { // only lesser set, items are equal when neither is less
+		equals = func(a, b T) bool {
+			return !lesser(a, b) && !lesser(b, a)
+		}
+	}
+	t.compare = func(a, b T) int {
+		if lesser(a, b) {
+			return -1
+		} else if equals(a, b) {
+			return 0
+		}
+		return 1
+	}
+}
+
+// Insert a new Item to a tree, following its duplicate policy
+func (t *Tree[T]) Insert(newValue T) {
+	t.Add(newValue)
+}
+
+// Add a new Item to a tree, following its duplicate policy
+//  returns ErrDuplicate if an equal item is in tree and policy is RejectDuplicates
+func (t *Tree[T]) Add(newValue T) error {
+	var y *TreeNode[T]
 	x := t.root
-	z := MakeNode(newValue, nil)
+	less := false
 	for x != nil {
 		y = x
-		if t.lesser(z.value, x.value) {
+		c := t.compare(newValue, x.value)
+		if c == 0 && t.duplicates != AllowDuplicates {
+			return t.insertDuplicate(x, newValue)
+		}
+		less = c < 0
+		if less {
 			x = x.left
 		} else {
 			x = x.right
 		}
 	}
+	z := MakeNode(newValue, nil)
 	z.parent = y
 	if y == nil {
 		t.root = z // tree was empty
-	} else if t.lesser(z.value, y.value) {
+	} else if less {
 		y.left = z
 	} else {
 		y.right = z
@@ -106,14 +230,24 @@ func (t *BinaryTree) Insert(newValue Item) {
 
 	// now fix AVL property on inserted node & upwards
 	RestoreAVLPropertyTree(z)
+	t.fixRoot()
+	return nil
+}
+
+// fixRoot follows parents up from root after rotations may have moved it down
+func (t *Tree[T]) fixRoot() {
+	for t.root != nil && t.root.parent != nil {
+		t.root = t.root.parent
+	}
 }
 
 // InsertRecursive a new Item to a tree
+//  equal items always get their own node, whatever the duplicate policy
 //  call RestoreAVLProperty(branchRoot) afterwards
-func (t *BinaryTree) InsertRecursive(branchRoot, newValue *Node) *Node {
+func (t *Tree[T]) InsertRecursive(branchRoot, newValue *TreeNode[T]) *TreeNode[T] {
 	if branchRoot == nil {
 		return newValue
-	} else if t.lesser(newValue.value, branchRoot.value) {
+	} else if t.compare(newValue.value, branchRoot.value) < 0 {
 		if branchRoot.left == nil {
 			newValue.parent = branchRoot
 			branchRoot.left = t.InsertRecursive(branchRoot.left, newValue)
@@ -125,6 +259,7 @@ func (t *BinaryTree) InsertRecursive(branchRoot, newValue *Node) *Node {
 			branchRoot.height = branchRoot.left.height + 1
 		}
 		branchRoot.left = RestoreAVLProperty(branchRoot.left) // correct level?
+		FixSize(branchRoot)
 		return branchRoot.left.parent
 	} else {
 		if branchRoot.right == nil {
@@ -138,56 +273,72 @@ func (t *BinaryTree) InsertRecursive(branchRoot, newValue *Node) *Node {
 			branchRoot.height = branchRoot.right.height + 1
 		}
 		branchRoot.right = RestoreAVLProperty(branchRoot.right) // correct level?
+		FixSize(branchRoot)
 		return branchRoot.right.parent
 	}
 }
 
 // GetRoot helper for treewalk
-func (t BinaryTree) GetRoot() *Node {
+func (t Tree[T]) GetRoot() *TreeNode[T] {
 	return t.root
 }
 
 // InOrderTreeWalk does left, current, right
+//  follows links rather than comparing items, so equal items are all sent
 //  closes channel when done
-func (t BinaryTree) InOrderTreeWalk(n *Node, c chan Item) {
-	x := n
-	var last *Node
-	for x != nil {
-		if x.left != nil && (last == nil || t.lesser(last.value, x.left.value)) {
-			x = x.left
-		} else {
-			if last != x && (last == nil || t.lesser(last.value, x.value)) {
-				c <- x.value
-				last = x
-			}
-			if x.right != nil && (last == nil || t.lesser(last.value, x.right.value)) {
-				x = x.right
-			} else {
-				x = x.parent
+func (t Tree[T]) InOrderTreeWalk(n *TreeNode[T], c chan T) {
+	if n != nil {
+		stop := n.parent
+		last, x := stop, n // last is node x was reached from
+		for x != stop {
+			var next *TreeNode[T]
+			if last == x.parent && x.left != nil { // came down, go left first
+				next = x.left
+			} else if last == x.right && x.right != nil { // came up from right, done here
+				next = x.parent
+			} else { // left side done
+				for i := 0; i < x.count; i++ {
+					c <- x.value
+				}
+				if x.right != nil {
+					next = x.right
+				} else {
+					next = x.parent
+				}
 			}
+			last, x = x, next
 		}
 	}
 	close(c)
 }
 
 // InOrderTreeWalkRecursive does left, current, right
-//  closes channel when done
-func (t BinaryTree) InOrderTreeWalkRecursive(n *Node, c chan Item) {
+//  closes channel when done, even if called on nil branch
+func (t Tree[T]) InOrderTreeWalkRecursive(n *TreeNode[T], c chan T) {
+	t.inOrderTreeWalkRecursive(n, c)
+	close(c)
+}
+
+// inOrderTreeWalkRecursive sends branch n to channel without closing it
+func (t Tree[T]) inOrderTreeWalkRecursive(n *TreeNode[T], c chan T) {
 	if n != nil {
-		t.InOrderTreeWalkRecursive(n.left, c)
-		c <- n.value
-		t.InOrderTreeWalkRecursive(n.right, c)
-		if t.equals(n.value, t.root.value) {
-			close(c)
+		t.inOrderTreeWalkRecursive(n.left, c)
+		for i := 0; i < n.count; i++ {
+			c <- n.value
 		}
+		t.inOrderTreeWalkRecursive(n.right, c)
 	}
 }
 
 // SearchRecursive to find node with Item in current branch or nil if none
-func (t BinaryTree) SearchRecursive(k Item, current *Node) *Node {
-	if current == nil || t.equals(current.value, k) {
+func (t Tree[T]) SearchRecursive(k T, current *TreeNode[T]) *TreeNode[T] {
+	if current == nil {
+		return current
+	}
+	c := t.compare(k, current.value)
+	if c == 0 {
 		return current
-	} else if t.lesser(k, current.value) {
+	} else if c < 0 {
 		return t.Search(k, current.left)
 	} else {
 		return t.Search(k, current.right)
@@ -195,14 +346,17 @@ func (t BinaryTree) SearchRecursive(k Item, current *Node) *Node {
 }
 
 // Search to find node with Item in current branch or nil if none
-func (t BinaryTree) Search(k Item, current *Node) *Node {
+func (t Tree[T]) Search(k T, current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	if x == nil {
 		x = t.root
 	}
 	kval := k
-	for x != nil && !t.equals(x.value, kval) {
-		if t.lesser(kval, x.value) {
+	for x != nil {
+		c := t.compare(kval, x.value)
+		if c == 0 {
+			break
+		} else if c < 0 {
 			x = x.left
 		} else {
 			x = x.right
@@ -212,7 +366,7 @@ func (t BinaryTree) Search(k Item, current *Node) *Node {
 }
 
 // GetMinimum finds lowest value
-func GetMinimum(current *Node) *Node {
+func GetMinimum[T any](current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	for x != nil && x.left != nil {
 		x = x.left
@@ -221,19 +375,22 @@ func GetMinimum(current *Node) *Node {
 }
 
 // GetTreeMinimum finds lowest value of tree
-func (t BinaryTree) GetTreeMinimum() *Node {
+func (t Tree[T]) GetTreeMinimum() *TreeNode[T] {
 	return GetMinimum(t.root)
 }
 
-// PopTreeMinimum finds lowest value of tree
-func (t *BinaryTree) PopTreeMinimum() *Item {
+// PopTreeMinimum removes lowest value of tree, nil if tree is empty
+func (t *Tree[T]) PopTreeMinimum() *T {
 	n := GetMinimum(t.root)
-	t.Delete(n)
+	if n == nil {
+		return nil
+	}
+	t.deleteOne(n)
 	return &n.value
 }
 
 // GetMaximum finds highest value
-func GetMaximum(current *Node) *Node {
+func GetMaximum[T any](current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	for x != nil && x.right != nil {
 		x = x.right
@@ -242,19 +399,22 @@ func GetMaximum(current *Node) *Node {
 }
 
 // GetTreeMaximum finds highest value of tree
-func (t BinaryTree) GetTreeMaximum() *Node {
+func (t Tree[T]) GetTreeMaximum() *TreeNode[T] {
 	return GetMaximum(t.root)
 }
 
-// PopTreeMaximum finds lowest value of tree
-func (t *BinaryTree) PopTreeMaximum() *Item {
+// PopTreeMaximum removes highest value of tree, nil if tree is empty
+func (t *Tree[T]) PopTreeMaximum() *T {
 	n := GetMaximum(t.root)
-	t.Delete(n)
+	if n == nil {
+		return nil
+	}
+	t.deleteOne(n)
 	return &n.value
 }
 
 // GetNext finds successor in order
-func (t BinaryTree) GetNext(current *Node) *Node {
+func (t Tree[T]) GetNext(current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	if x == nil {
 		return nil
@@ -271,7 +431,7 @@ func (t BinaryTree) GetNext(current *Node) *Node {
 }
 
 // GetPrevious finds predecessor in order
-func (t BinaryTree) GetPrevious(current *Node) *Node {
+func (t Tree[T]) GetPrevious(current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	if x == nil {
 		return nil
@@ -291,7 +451,7 @@ func (t BinaryTree) GetPrevious(current *Node) *Node {
 //  Updates v's parent link
 //  Does not update u, sub branches, heights, etc
 //  u must exist, v may be nil
-func (t *BinaryTree) Transplant(u, v *Node) {
+func (t *Tree[T]) Transplant(u, v *TreeNode[T]) {
 	if u == nil {
 		return // u must exist
 	}
@@ -307,22 +467,25 @@ func (t *BinaryTree) Transplant(u, v *Node) {
 	}
 }
 
-// Delete removes a node and adjusts tree accordingly
-func (t *BinaryTree) Delete(z *Node) {
+// Delete removes a node, with all its copies, and adjusts tree accordingly
+func (t *Tree[T]) Delete(z *TreeNode[T]) {
 	if z == nil {
 		return
 	}
-	var y, w *Node
+	var y, w *TreeNode[T]
 	if z.left == nil { // no or only right child
 		// use right child, even if nil
 		y = z.right // could be nil, don't ref members
+		w = z.parent
 		t.Transplant(z, y)
 	} else if z.right == nil { // only left child
 		// use left child, not nil
 		y = z.left
+		w = z.parent
 		t.Transplant(z, y)
 	} else { // both children present
 		y = GetMinimum(z.right)
+		w = y // y's left changes, heights checked from y on up
 
 		if y.parent != z {
 			w = y.parent // for fixing heights later
@@ -336,34 +499,13 @@ func (t *BinaryTree) Delete(z *Node) {
 	}
 
 	// fix heights
-	if w != nil {
-		// heights need to be checked from y's original parent on up
-		FixAllHeights(w)
-	} else {
-		// height only needs to be checked starting at y
-		FixAllHeights(y)
-	}
+	// w is the lowest node whose subtree changed, nil if z was the only node
+	FixAllHeights(w)
 
 	// Restore AVL property
 	// loop upwards
-	for cur := y; cur != nil; cur = cur.parent {
-		bal := IsBalanced(cur)
-		if bal < -1 { // too right heavy
-			if IsBalanced(cur.right) < 0 { // right right case
-				LeftRotate(cur)
-			} else { // right left case
-				RightRotate(cur.right)
-				LeftRotate(cur)
-			}
-		} else if bal > 1 { // too left heavy
-			if IsBalanced(cur.left) < 0 { // left right case
-				LeftRotate(cur.left)
-				RightRotate(cur)
-			} else { // left left case
-				RightRotate(cur)
-			}
-		}
-	}
+	RestoreAVLPropertyTree(w)
+	t.fixRoot()
 
 	// clean up z
 	z.left = nil
@@ -372,7 +514,7 @@ func (t *BinaryTree) Delete(z *Node) {
 }
 
 // GetHeight returns height of node, including -1 for nil nodes
-func GetHeight(n *Node) int {
+func GetHeight[T any](n *TreeNode[T]) int {
 	if n == nil {
 		return -1
 	}
@@ -380,15 +522,56 @@ func GetHeight(n *Node) int {
 }
 
 // GetTreeHeight helper return overall tree height
-func (t BinaryTree) GetTreeHeight() int {
+func (t Tree[T]) GetTreeHeight() int {
 	return GetHeight(t.root)
 }
 
+// Len returns number of items in tree
+func (t Tree[T]) Len() int {
+	return GetSize(t.root)
+}
+
+// Select finds node with the k-th smallest item, counting from 0, or nil if out of range
+func (t Tree[T]) Select(k int) *TreeNode[T] {
+	x := t.root
+	for x != nil {
+		l := GetSize(x.left)
+		if k < l {
+			x = x.left
+		} else if k >= l+x.count {
+			k -= l + x.count
+			x = x.right
+		} else {
+			return x
+		}
+	}
+	return nil
+}
+
+// Rank returns number of items less than k, whether or not k is in tree
+func (t Tree[T]) Rank(k T) int {
+	return t.rank(k, false)
+}
+
+// rank returns number of items less than k, or less than or equal if inclusive
+func (t Tree[T]) rank(k T, inclusive bool) int {
+	r := 0
+	for x := t.root; x != nil; {
+		if c := t.compare(k, x.value); c < 0 || (c == 0 && !inclusive) {
+			x = x.left
+		} else {
+			r += GetSize(x.left) + x.count
+			x = x.right
+		}
+	}
+	return r
+}
+
 // IsBalanced returns actual height imbalance
 //  0 if children of a node are equal,
 //  + for left heavy, - for right heavy
 //  per AVL property, balanced: result <= +/-1
-func IsBalanced(n *Node) int {
+func IsBalanced[T any](n *TreeNode[T]) int {
 	if n == nil {
 		return 0
 	}
@@ -396,7 +579,7 @@ func IsBalanced(n *Node) int {
 }
 
 // FixHeight resets a node's height based on its current children
-func FixHeight(n *Node) {
+func FixHeight[T any](n *TreeNode[T]) {
 	r := GetHeight(n.right)
 	l := GetHeight(n.left)
 	if r > l {
@@ -406,16 +589,30 @@ func FixHeight(n *Node) {
 	}
 }
 
-// FixAllHeights resets height of node and successive parents
-func FixAllHeights(n *Node) {
+// GetSize returns number of items in subtree of n, counting copies, 0 for nil nodes
+func GetSize[T any](n *TreeNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// FixSize resets a node's subtree size based on its current children
+func FixSize[T any](n *TreeNode[T]) {
+	n.size = GetSize(n.left) + GetSize(n.right) + n.count
+}
+
+// FixAllHeights resets height and size of node and successive parents
+func FixAllHeights[T any](n *TreeNode[T]) {
 	for currentNode := n; currentNode != nil; currentNode = currentNode.parent {
 		FixHeight(currentNode)
+		FixSize(currentNode)
 	}
 }
 
 // LeftRotate rotates a node with its right child
 //  returns new subtree root, n or its replacement
-func LeftRotate(n *Node) *Node {
+func LeftRotate[T any](n *TreeNode[T]) *TreeNode[T] {
 	if n == nil || n.right == nil {
 		return n // can't rotate left
 	}
@@ -423,6 +620,9 @@ func LeftRotate(n *Node) *Node {
 	y.parent = n.parent
 	n.parent = y
 	n.right = y.left
+	if n.right != nil {
+		n.right.parent = n
+	}
 	y.left = n
 	if y.parent != nil { // not tree root
 		if y.parent.left == n {
@@ -433,12 +633,14 @@ func LeftRotate(n *Node) *Node {
 	}
 	FixHeight(n)
 	FixHeight(y) // needed?
+	FixSize(n)
+	FixSize(y)
 	return y
 }
 
 // RightRotate rotates a node with its left child
 //  returns new subtree root, n or its replacement
-func RightRotate(n *Node) *Node {
+func RightRotate[T any](n *TreeNode[T]) *TreeNode[T] {
 	if n == nil || n.left == nil {
 		return n // can't rotate right
 	}
@@ -446,6 +648,9 @@ func RightRotate(n *Node) *Node {
 	x.parent = n.parent
 	n.parent = x
 	n.left = x.right
+	if n.left != nil {
+		n.left.parent = n
+	}
 	x.right = n
 	if x.parent != nil { // not tree root
 		if x.parent.right == n {
@@ -456,12 +661,14 @@ func RightRotate(n *Node) *Node {
 	}
 	FixHeight(n)
 	FixHeight(x) // needed?
+	FixSize(n)
+	FixSize(x)
 	return x
 }
 
 // RestoreAVLProperty of an inserted node only
 //  returns n, or its replacement if rotated
-func RestoreAVLProperty(n *Node) *Node {
+func RestoreAVLProperty[T any](n *TreeNode[T]) *TreeNode[T] {
 	b := IsBalanced(n)
 	if b < -1 { // too right heavy, fix
 		if IsBalanced(n.right) > 0 {
@@ -479,9 +686,12 @@ func RestoreAVLProperty(n *Node) *Node {
 	return n
 }
 
-// RestoreAVLPropertyTree of an inserted node, traversing upwards
-func RestoreAVLPropertyTree(n *Node) {
-	for currentNode := n; n != nil; n = n.parent {
+// RestoreAVLPropertyTree of an inserted or deleted node, traversing upwards
+//  heights are refreshed on the way up since rotations below can shrink them
+func RestoreAVLPropertyTree[T any](n *TreeNode[T]) {
+	for currentNode := n; currentNode != nil; currentNode = currentNode.parent {
+		FixHeight(currentNode)
+		FixSize(currentNode)
 		currentNode = RestoreAVLProperty(currentNode)
 	}
 }
@@ -489,16 +699,26 @@ func RestoreAVLPropertyTree(n *Node) {
 // Using AVL tree as a priority queue
 
 // Push - alias for Insert()
-func (t *BinaryTree) Push(key Item) {
+func (t *Tree[T]) Push(key T) {
 	t.Insert(key)
 }
 
-// Peek - alias for GetTreeMinimum()
-func (t BinaryTree) Peek() Item {
-	return t.GetTreeMinimum()
+// Peek - value of GetTreeMinimum(), zero value if tree is empty
+func (t Tree[T]) Peek() T {
+	n := t.GetTreeMinimum()
+	if n == nil {
+		var zero T
+		return zero
+	}
+	return n.value
 }
 
-// Pop - alias for PopTreeMinimum()
-func (t *BinaryTree) Pop() Item {
-	return t.PopTreeMinimum()
+// Pop - value of PopTreeMinimum(), zero value if tree is empty
+func (t *Tree[T]) Pop() T {
+	v := t.PopTreeMinimum()
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
 }
@@ -3,6 +3,8 @@
 package avl
 
 import (
+	"math/rand"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -371,3 +373,259 @@ func TestBinarySearchTreeWalkRecursives(t *testing.T) {
 		}
 	}
 }
+
+func TestGenericTree(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7, 1, 3, 4, 24, 14, 34, -1, 12, 18, 10, 16} {
+		tree.Insert(n)
+	}
+	tree.Delete(tree.Search(24, nil))
+
+	want := []int{-1, 1, 2, 3, 4, 5, 7, 9, 10, 12, 14, 16, 18, 34}
+	ch := make(chan int, 1)
+	go tree.InOrderTreeWalk(tree.GetRoot(), ch)
+	x := 0
+	for y := range ch {
+		if y != want[x] {
+			t.Errorf("Invalid order, found: %d, expected: %d", y, want[x])
+			return
+		}
+		x++
+	}
+	if x != len(want) {
+		t.Errorf("Walked %d items, expected: %d", x, len(want))
+	}
+	if tree.Search(24, nil) != nil {
+		t.Errorf("Found deleted item 24")
+	}
+	if tree.Peek() != -1 || tree.Pop() != -1 || tree.Peek() != 1 {
+		t.Errorf("Invalid priority queue order")
+	}
+
+	// custom item type, no assertions needed in comparisons
+	type person struct {
+		name string
+		age  int
+	}
+	var people Tree[person]
+	people.Init(person{"kenny", 9}, func(a, b person) bool {
+		return a.age < b.age
+	}, func(a, b person) bool {
+		return a.age == b.age
+	})
+	people.Insert(person{"chef", 40})
+	people.Insert(person{"timmy", 8})
+	if n := people.Search(person{age: 40}, nil); n == nil || n.value.name != "chef" {
+		t.Errorf("Search by age failed")
+	}
+}
+
+func TestCompareTree(t *testing.T) {
+	tests := []struct {
+		givenArray       []Item
+		givenCompareFunc func(a, b Item) int
+		givenDeletables  []Item
+
+		wantFinalArray []Item
+	}{
+		{
+			[]Item{5, 2, 9, 7, 1, 3, 4, 24, 14, 34, -1, 12, 18, 10, 16},
+			nil, // default int sort
+			[]Item{24, -1, 5},
+
+			[]Item{1, 2, 3, 4, 7, 9, 10, 12, 14, 16, 18, 34},
+		},
+		{
+			[]Item{"kenny", "kyle", "eric", "chef", "stan", "timmy"},
+			func(a, b Item) int {
+				return strings.Compare(a.(string), b.(string))
+			},
+			[]Item{"timmy", "kenny"},
+
+			[]Item{"chef", "eric", "kyle", "stan"},
+		},
+		{
+			[]Item{"kenny", "kyle", "eric", "chef", "stan", "timmy"},
+			func(a, b Item) int {
+				return strings.Compare(b.(string), a.(string)) // reverse sort
+			},
+			[]Item{"chef"},
+
+			[]Item{"timmy", "stan", "kyle", "kenny", "eric"},
+		},
+	}
+
+	for i, test := range tests {
+		var tree BinaryTree
+
+		for x, n := range test.givenArray {
+			if x == 0 {
+				tree.InitCompare(n, test.givenCompareFunc)
+			} else {
+				tree.Insert(n)
+			}
+		}
+
+		for _, n := range test.givenDeletables {
+			tree.Delete(tree.Search(n, nil))
+		}
+
+		ch := make(chan Item, 1)
+		go tree.InOrderTreeWalk(tree.GetRoot(), ch)
+
+		x := 0
+		for y := range ch {
+			// lesser and equals are derived from the comparison
+			if !tree.equals(y, test.wantFinalArray[x]) || (x > 0 && !tree.lesser(test.wantFinalArray[x-1], y)) {
+				t.Errorf("%d: Invalid order, found: %v, expected: %v", i, y, test.wantFinalArray[x])
+				return
+			}
+			x++
+		}
+		if x != len(test.wantFinalArray) {
+			t.Errorf("%d: Walked %d items, expected: %d", i, x, len(test.wantFinalArray))
+		}
+	}
+
+	// comparison derived from lesser and equals
+	tree := New(func(a, b string) bool {
+		return a < b
+	}, func(a, b string) bool {
+		return a == b
+	})
+	for _, s := range []string{"b", "a", "c"} {
+		tree.Insert(s)
+	}
+	if tree.compare("a", "b") >= 0 || tree.compare("b", "b") != 0 || tree.compare("c", "b") <= 0 {
+		t.Errorf("Invalid derived comparison")
+	}
+}
+
+// checkAVL verifies parent links, stored heights, sizes and balance below n, returns height
+func checkAVL[T any](t *testing.T, n *TreeNode[T]) int {
+	if n == nil {
+		return -1
+	}
+	l := checkAVL(t, n.left)
+	r := checkAVL(t, n.right)
+	if (n.left != nil && n.left.parent != n) || (n.right != nil && n.right.parent != n) {
+		t.Fatalf("Bad parent link below %v", n.value)
+	}
+	h := max(l, r) + 1
+	if h != n.height {
+		t.Fatalf("Bad height at %v, found: %d, expected: %d", n.value, n.height, h)
+	}
+	if n.size != GetSize(n.left)+GetSize(n.right)+n.count {
+		t.Fatalf("Bad size at %v, found: %d", n.value, n.size)
+	}
+	if l-r > 1 || r-l > 1 {
+		t.Fatalf("Unbalanced at %v, left: %d, right: %d", n.value, l, r)
+	}
+	return h
+}
+
+func TestAVLBalance(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		tree := NewOrdered[int]()
+		present := map[int]bool{}
+		for i := 0; i < 80; i++ {
+			if n := r.Intn(100); !present[n] {
+				present[n] = true
+				tree.Insert(n)
+				checkAVL(t, tree.GetRoot())
+			}
+		}
+		for i := 0; i < 80; i++ {
+			if n := r.Intn(100); present[n] {
+				delete(present, n)
+				tree.Delete(tree.Search(n, nil))
+				checkAVL(t, tree.GetRoot())
+			}
+		}
+
+		x := 0
+		for n := tree.GetTreeMinimum(); n != nil; n = tree.GetNext(n) {
+			if !present[n.value] {
+				t.Fatalf("%d: Found deleted item %d", trial, n.value)
+			}
+			x++
+		}
+		if x != len(present) {
+			t.Fatalf("%d: Walked %d items, expected: %d", trial, x, len(present))
+		}
+	}
+}
+
+func TestOrderStatistics(t *testing.T) {
+	var tree BinaryTree
+	for x, n := range []Item{5, 2, 9, 7, 1, 3, 4, 24, 14, 34, -1, 12, 18, 10, 16} {
+		if x == 0 {
+			tree.Init(n, nil, nil)
+		} else {
+			tree.Insert(n)
+		}
+	}
+	tree.Delete(tree.Search(24, nil))
+	tree.Delete(tree.Search(5, nil))
+
+	want := []Item{-1, 1, 2, 3, 4, 7, 9, 10, 12, 14, 16, 18, 34}
+	if tree.Len() != len(want) {
+		t.Errorf("Invalid length, found: %d, expected: %d", tree.Len(), len(want))
+	}
+	for k, n := range want {
+		if s := tree.Select(k); s == nil || s.value != n {
+			t.Errorf("%d: Invalid select, expected: %d", k, n)
+		}
+		if r := tree.Rank(n); r != k {
+			t.Errorf("%d: Invalid rank, found: %d", k, r)
+		}
+	}
+	if tree.Select(-1) != nil || tree.Select(len(want)) != nil {
+		t.Errorf("Select out of range found a node")
+	}
+
+	// items not in tree
+	tests := []struct {
+		givenItem Item
+
+		wantRank int
+	}{
+		{-5, 0},
+		{5, 5},
+		{24, 12},
+		{99, 13},
+	}
+	for _, test := range tests {
+		if r := tree.Rank(test.givenItem); r != test.wantRank {
+			t.Errorf("Invalid rank of %d, found: %d, expected: %d", test.givenItem, r, test.wantRank)
+		}
+	}
+
+	var empty BinaryTree
+	if empty.Len() != 0 || empty.Select(0) != nil {
+		t.Errorf("Empty tree has items")
+	}
+}
+
+func TestLesserOnlyTree(t *testing.T) {
+	var tree BinaryTree
+	tree.SetPrioritizeTreeItem(func(a, b Item) bool {
+		return a.(int) < b.(int)
+	})
+	for _, n := range []Item{5, 2, 9, 2, 7} {
+		tree.Insert(n)
+	}
+	ch := make(chan Item, 1)
+	go tree.InOrderTreeWalk(tree.GetRoot(), ch)
+	var got []Item
+	for y := range ch {
+		got = append(got, y)
+	}
+	if want := []Item{2, 2, 5, 7, 9}; !slices.Equal(got, want) {
+		t.Errorf("Invalid order, found: %v, expected: %v", got, want)
+	}
+	if n := tree.Search(7, nil); n == nil || n.value != 7 {
+		t.Errorf("Invalid search, found: %v", n)
+	}
+}
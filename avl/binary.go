@@ -0,0 +1,153 @@
+// binary.go
+
+package avl
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/PuppyKhan/jebe/internal/wire"
+)
+
+// ErrNoComparison - returned by UnmarshalBinary() and UnmarshalJSON() into a tree without comparisons set
+var ErrNoComparison = errors.New("avl: no comparison set")
+
+// Child flags of an encoded node
+const (
+	hasLeft  = 1 << iota // left child follows
+	hasRight             // right child follows, after any left branch
+)
+
+// SetCodec sets how items are encoded by MarshalBinary and decoded by UnmarshalBinary
+//  defaults to collection.GobCodec, needing gob.Register for custom types held in Items
+func (t *Tree[T]) SetCodec(c Codec[T]) {
+	t.codec = c
+}
+
+// MarshalBinary encodes tree with its exact shape, also used by encoding/gob
+//  duplicate policy and copy counts are kept, comparisons and codec are not
+//  heights and sizes follow from the shape, so aren't stored
+func (t Tree[T]) MarshalBinary() ([]byte, error) {
+	e := wire.NewEncoder(wire.AVL, t.codec)
+	e.Byte(byte(t.duplicates))
+	nodes := preOrderNodes(t.root)
+	e.Uint(uint64(len(nodes)))
+	for _, x := range nodes {
+		var flags byte
+		if x.left != nil {
+			flags |= hasLeft
+		}
+		if x.right != nil {
+			flags |= hasRight
+		}
+		e.Byte(flags)
+		e.Uint(uint64(x.count))
+		if err := e.Item(x.value); err != nil {
+			return nil, err
+		}
+	}
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinary replaces contents of tree with one encoded by MarshalBinary
+//  comparisons, and codec if not the default, must be set first, else ErrNoComparison is returned
+//  shape is restored as encoded without rebalancing, then heights and sizes recomputed
+//  decoded tree is checked with Validate(), on any error tree is left unchanged
+func (t *Tree[T]) UnmarshalBinary(data []byte) error {
+	if t.compare == nil {
+		return ErrNoComparison
+	}
+	d, err := wire.NewDecoder(data, wire.AVL, t.codec)
+	if err != nil {
+		return err
+	}
+	policy, err := d.Byte()
+	if err != nil {
+		return err
+	}
+	if Duplicates(policy) > CountDuplicates {
+		return fmt.Errorf("avl: unknown duplicate policy %d", policy)
+	}
+	n, err := d.Len(3) // flags, count and item length
+	if err != nil {
+		return err
+	}
+	nodes := make([]*TreeNode[T], 0, n)
+	var root, pendingLeft *TreeNode[T]
+	var pendingRight []*TreeNode[T] // nodes still waiting for their right child
+	for i := 0; i < n; i++ {
+		flags, err := d.Byte()
+		if err != nil {
+			return err
+		}
+		count, err := d.Uint()
+		if err != nil {
+			return err
+		}
+		v, err := d.Item()
+		if err != nil {
+			return err
+		}
+		if flags&^(hasLeft|hasRight) != 0 || count == 0 || count > math.MaxInt {
+			return wire.ErrCorrupt
+		}
+		x := MakeNode(v, nil)
+		x.count = int(count)
+		nodes = append(nodes, x)
+		if i == 0 {
+			root = x
+		} else if pendingLeft != nil {
+			x.parent, pendingLeft.left = pendingLeft, x
+		} else if last := len(pendingRight) - 1; last >= 0 {
+			x.parent, pendingRight[last].right = pendingRight[last], x
+			pendingRight = pendingRight[:last]
+		} else {
+			return wire.ErrCorrupt
+		}
+		pendingLeft = nil
+		if flags&hasRight != 0 {
+			pendingRight = append(pendingRight, x)
+		}
+		if flags&hasLeft != 0 {
+			pendingLeft = x
+		}
+	}
+	if pendingLeft != nil || len(pendingRight) > 0 {
+		return wire.ErrCorrupt
+	}
+	if err := d.Done(); err != nil {
+		return err
+	}
+	for i := len(nodes) - 1; i >= 0; i-- { // children before parents
+		FixHeight(nodes[i])
+		FixSize(nodes[i])
+	}
+	loaded := *t
+	loaded.root, loaded.duplicates = root, Duplicates(policy)
+	if err := loaded.Validate(); err != nil {
+		return fmt.Errorf("avl: invalid binary data: %w", err)
+	}
+	t.root, t.duplicates = root, loaded.duplicates
+	return nil
+}
+
+// preOrderNodes returns nodes of branch n in pre order, the order they are encoded
+func preOrderNodes[T any](n *TreeNode[T]) []*TreeNode[T] {
+	var nodes, stack []*TreeNode[T]
+	if n != nil {
+		stack = append(stack, n)
+	}
+	for len(stack) > 0 {
+		x := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		nodes = append(nodes, x)
+		if x.right != nil {
+			stack = append(stack, x.right)
+		}
+		if x.left != nil {
+			stack = append(stack, x.left)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,179 @@
+// binary_test.go
+
+package avl
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree := NewOrdered[int]()
+	for i := 0; i < 200; i++ {
+		if n := r.Intn(50); i%3 == 2 {
+			tree.Remove(n)
+		} else {
+			tree.Insert(n)
+		}
+	}
+	data, err := tree.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := NewOrdered[int]()
+	loaded.Insert(-5) // replaced
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	// same shape, heights and sizes
+	if loaded.String() != tree.String() {
+		t.Errorf("Invalid shape, found:\n%s\nexpected:\n%s", loaded.String(), tree.String())
+	}
+	if loaded.GetTreeHeight() != tree.GetTreeHeight() || loaded.Len() != tree.Len() {
+		t.Errorf("Invalid height or length, found: %d %d, expected: %d %d", loaded.GetTreeHeight(), loaded.Len(), tree.GetTreeHeight(), tree.Len())
+	}
+
+	empty, _ := NewOrdered[int]().MarshalBinary()
+	if err := loaded.UnmarshalBinary(empty); err != nil || loaded.Len() != 0 {
+		t.Errorf("Invalid empty tree, found: %v, %d items", err, loaded.Len())
+	}
+}
+
+func TestMarshalBinaryDuplicates(t *testing.T) {
+	tree := NewOrdered[int]()
+	tree.SetDuplicates(CountDuplicates)
+	for _, n := range []int{5, 2, 5, 9, 5, 2} {
+		tree.Insert(n)
+	}
+	data, _ := tree.MarshalBinary()
+	loaded := NewOrdered[int]()
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.GetDuplicates() != CountDuplicates || loaded.Count(5) != 3 || loaded.Len() != 6 {
+		t.Errorf("Invalid multiset, found: %v", slices.Collect(loaded.All()))
+	}
+}
+
+func TestMarshalBinaryCodec(t *testing.T) {
+	tree := NewCompare(strings.Compare)
+	for _, s := range []string{"m", "c", "x", "a"} {
+		tree.Insert(s)
+	}
+	codec := Codec[string]{
+		Encode: func(s string) ([]byte, error) { return []byte(s), nil },
+		Decode: func(b []byte) (string, error) { return string(b), nil },
+	}
+	tree.SetCodec(codec)
+	data, err := tree.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := NewCompare(strings.Compare)
+	loaded.SetCodec(codec)
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := slices.Collect(loaded.All()), []string{"a", "c", "m", "x"}; !slices.Equal(got, want) {
+		t.Errorf("Invalid order, found: %v, expected: %v", got, want)
+	}
+
+	failing := errors.New("failing codec")
+	tree.SetCodec(Codec[string]{Encode: func(string) ([]byte, error) { return nil, failing }})
+	if _, err := tree.MarshalBinary(); err != failing {
+		t.Errorf("Codec error not returned, found: %v", err)
+	}
+}
+
+func TestGob(t *testing.T) {
+	tree := New[Item](nil, nil)
+	for _, n := range []int{5, 2, 9, 7, 1} {
+		tree.Insert(n)
+	}
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(tree); err != nil {
+		t.Fatal(err)
+	}
+	loaded := New[Item](nil, nil)
+	if err := gob.NewDecoder(&b).Decode(loaded); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.String() != tree.String() {
+		t.Errorf("Invalid shape, found:\n%s\nexpected:\n%s", loaded.String(), tree.String())
+	}
+
+	// a zero value has no comparison to check items against
+	words := NewCompare(func(a, b Item) int {
+		return strings.Compare(a.(string), b.(string))
+	})
+	words.Insert("kyle")
+	words.Insert("eric")
+	b.Reset()
+	if err := gob.NewEncoder(&b).Encode(words); err != nil {
+		t.Fatal(err)
+	}
+	var zero BinaryTree
+	if err := gob.NewDecoder(&b).Decode(&zero); err != ErrNoComparison || zero.GetRoot() != nil {
+		t.Errorf("Missing comparison not reported, found: %v", err)
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7, 1} {
+		tree.Insert(n)
+	}
+	data, _ := tree.MarshalBinary()
+
+	// a chain, ordered but unbalanced
+	chain := NewOrdered[int]()
+	chain.root = MakeNode(1, nil)
+	chain.root.right = MakeNode(2, chain.root)
+	chain.root.right.right = MakeNode(3, chain.root.right)
+	unbalanced, _ := chain.MarshalBinary()
+
+	// root flags, after header, policy and node count
+	leaf, badFlags := slices.Clone(data), slices.Clone(data)
+	leaf[8], badFlags[8] = 0, 4
+
+	tests := []struct {
+		givenData []byte
+
+		wantError string
+	}{
+		{nil, "corrupt"},
+		{[]byte("gebe\x01\x03"), "corrupt"},
+		{append([]byte("jebe\x02\x03"), data[6:]...), "version"},
+		{append([]byte("jebe\x01\x02"), data[6:]...), "kind"},
+		{data[:len(data)-1], "corrupt"},
+		{append(slices.Clone(data), 0), "corrupt"},
+		{leaf, "corrupt"},
+		{badFlags, "corrupt"},
+		{unbalanced, "unbalanced"},
+	}
+	for i, test := range tests {
+		loaded := NewOrdered[int]()
+		loaded.Insert(42)
+		if err := loaded.UnmarshalBinary(test.givenData); err == nil || !strings.Contains(err.Error(), test.wantError) {
+			t.Errorf("%d: Invalid error, found: %v, expected: %s", i, err, test.wantError)
+		}
+		if got := slices.Collect(loaded.All()); !slices.Equal(got, []int{42}) {
+			t.Errorf("%d: Tree changed by invalid data, found: %v", i, got)
+		}
+	}
+
+	// items out of order for the receiver's comparison
+	loaded := NewCompare(func(a, b int) int { return b - a })
+	if err := loaded.UnmarshalBinary(data); err == nil || !strings.Contains(err.Error(), "out of order") {
+		t.Errorf("Order violation not reported, found: %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+// build_test.go
+
+package avl
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestFromSorted(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 3, 7, 100, 1000} {
+		items := make([]int, size)
+		for i := range items {
+			items[i] = i * 2
+		}
+		tree := NewOrdered[int]()
+		tree.Insert(-5) // replaced
+		if err := tree.FromSorted(items); err != nil {
+			t.Fatalf("%d: %v", size, err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("%d: %v", size, err)
+		}
+		if got := slices.Collect(tree.All()); !slices.Equal(got, items) {
+			t.Errorf("%d: Invalid order, found: %v", size, got)
+		}
+		// perfectly balanced, height is floor(log2(size))
+		if want := bits.Len(uint(size)) - 1; tree.GetTreeHeight() != want {
+			t.Errorf("%d: Invalid height, found: %d, expected: %d", size, tree.GetTreeHeight(), want)
+		}
+		if tree.Len() != size {
+			t.Errorf("%d: Invalid length, found: %d", size, tree.Len())
+		}
+	}
+
+	tree := NewOrdered[int]()
+	tree.Insert(1)
+	if err := tree.FromSorted([]int{1, 3, 2}); err != ErrUnsorted {
+		t.Errorf("Unsorted items not reported, found: %v", err)
+	}
+	if tree.Len() != 1 {
+		t.Errorf("Tree changed by unsorted items")
+	}
+}
+
+func TestFromSortedDuplicates(t *testing.T) {
+	tests := []struct {
+		givenPolicy Duplicates
+
+		wantArray []int
+		wantNodes int
+	}{
+		{AllowDuplicates, []int{1, 1, 2, 2, 2, 3, 3}, 7},
+		{RejectDuplicates, []int{1, 2, 3}, 3},
+		{KeepFirstDuplicate, []int{1, 2, 3}, 3},
+		{CountDuplicates, []int{1, 1, 2, 2, 2, 3, 3}, 3},
+	}
+	for _, test := range tests {
+		tree := NewOrdered[int]()
+		tree.SetDuplicates(test.givenPolicy)
+		tree.FromSorted([]int{1, 1, 2, 2, 2, 3, 3})
+		if err := tree.Validate(); err != nil {
+			t.Errorf("%d: %v", test.givenPolicy, err)
+		}
+		if got := slices.Collect(tree.All()); !slices.Equal(got, test.wantArray) {
+			t.Errorf("%d: Invalid order, found: %v, expected: %v", test.givenPolicy, got, test.wantArray)
+		}
+		nodes := 0
+		for n := tree.GetTreeMinimum(); n != nil; n = tree.GetNext(n) {
+			nodes++
+		}
+		if nodes != test.wantNodes {
+			t.Errorf("%d: Invalid node count, found: %d, expected: %d", test.givenPolicy, nodes, test.wantNodes)
+		}
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	items := r.Perm(500)
+	tree := NewOrdered[int]()
+	tree.FromSlice(items)
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.IsSorted(slices.Collect(tree.All())) || tree.Len() != len(items) {
+		t.Errorf("Invalid tree from slice")
+	}
+	if slices.IsSorted(items) {
+		t.Errorf("Given slice was sorted in place")
+	}
+}
+
+func BenchmarkFromSorted(b *testing.B) {
+	for _, n := range []int{1000, 100000} {
+		items := make([]int, n)
+		for i := range items {
+			items[i] = i
+		}
+		b.Run(fmt.Sprintf("Insert/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				tree := NewOrdered[int]()
+				for _, v := range items {
+					tree.Insert(v)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("FromSorted/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				NewOrdered[int]().FromSorted(items)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// debug.go
+
+package avl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Validate checks ordering, parent links, copy counts against the duplicate policy,
+//  stored heights and sizes against actual ones, and AVL balance of every node
+//  returns an error naming the first offending node, nil if tree is valid
+func (t Tree[T]) Validate() error {
+	if t.root == nil {
+		return nil
+	}
+	if t.root.parent != nil {
+		return fmt.Errorf("root %v has a parent", t.root.value)
+	}
+	var last *TreeNode[T]
+	_, err := t.validate(t.root, &last)
+	return err
+}
+
+// validate checks branch n in order, last being the node visited before it
+//  returns actual height of n
+func (t Tree[T]) validate(n *TreeNode[T], last **TreeNode[T]) (int, error) {
+	if n == nil {
+		return -1, nil
+	}
+	for _, c := range []*TreeNode[T]{n.left, n.right} {
+		if c != nil && c.parent != n {
+			return 0, fmt.Errorf("node %v has wrong parent link", c.value)
+		}
+	}
+	l, err := t.validate(n.left, last)
+	if err != nil {
+		return 0, err
+	}
+	if err := t.validateNode(*last, n); err != nil {
+		return 0, err
+	}
+	*last = n
+	r, err := t.validate(n.right, last)
+	if err != nil {
+		return 0, err
+	}
+	h := max(l, r) + 1
+	if n.height != h {
+		return 0, fmt.Errorf("node %v has height %d, actual %d", n.value, n.height, h)
+	}
+	if size := GetSize(n.left) + GetSize(n.right) + n.count; n.size != size {
+		return 0, fmt.Errorf("node %v has size %d, actual %d", n.value, n.size, size)
+	}
+	if b := IsBalanced(n); b < -1 || b > 1 {
+		return 0, fmt.Errorf("node %v is unbalanced by %d", n.value, b)
+	}
+	return h, nil
+}
+
+// validateNode checks count of n and its order after prev, nil if n is first
+func (t Tree[T]) validateNode(prev, n *TreeNode[T]) error {
+	if n.count < 1 || (n.count > 1 && t.duplicates != CountDuplicates) {
+		return fmt.Errorf("node %v has %d copies", n.value, n.count)
+	}
+	if prev == nil {
+		return nil
+	}
+	c := t.compare(prev.value, n.value)
+	if c > 0 {
+		return fmt.Errorf("node %v is out of order after %v", n.value, prev.value)
+	}
+	if c == 0 && t.duplicates != AllowDuplicates {
+		return fmt.Errorf("node %v duplicates %v", n.value, prev.value)
+	}
+	return nil
+}
+
+// Dump writes tree sideways, root on the left and highest item on top, one node per line
+//  copies are shown as "x3" after the item
+func (t Tree[T]) Dump(w io.Writer) error {
+	return dump(w, t.root, 0)
+}
+
+// dump writes branch n indented by its depth, right branch first
+func dump[T any](w io.Writer, n *TreeNode[T], depth int) error {
+	if n == nil {
+		return nil
+	}
+	if err := dump(w, n.right, depth+1); err != nil {
+		return err
+	}
+	copies := ""
+	if n.count > 1 {
+		copies = fmt.Sprintf(" x%d", n.count)
+	}
+	if _, err := fmt.Fprintf(w, "%s%v%s\n", strings.Repeat("    ", depth), n.value, copies); err != nil {
+		return err
+	}
+	return dump(w, n.left, depth+1)
+}
+
+// String returns tree as written by Dump()
+func (t Tree[T]) String() string {
+	var b strings.Builder
+	t.Dump(&b)
+	return b.String()
+}
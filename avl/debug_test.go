@@ -0,0 +1,101 @@
+// debug_test.go
+
+package avl
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree := NewOrdered[int]()
+	tree.SetDuplicates(CountDuplicates)
+	for i := 0; i < 200; i++ {
+		if n := r.Intn(50); i%3 == 2 {
+			tree.Remove(n)
+		} else {
+			tree.Insert(n)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+	}
+
+	tree = NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7, 1} {
+		tree.Insert(n)
+	}
+	tests := []struct {
+		givenCorruption func(n *TreeNode[int])
+		givenRepair     func(n *TreeNode[int])
+
+		wantError string
+	}{
+		{
+			func(n *TreeNode[int]) { n.value = 99 },
+			func(n *TreeNode[int]) { n.value = 7 },
+
+			"99",
+		},
+		{
+			func(n *TreeNode[int]) { n.parent = n.parent.parent },
+			func(n *TreeNode[int]) { n.parent = n.parent.right },
+
+			"parent",
+		},
+		{
+			func(n *TreeNode[int]) { n.height = 3 },
+			func(n *TreeNode[int]) { n.height = 0 },
+
+			"height",
+		},
+		{
+			func(n *TreeNode[int]) { n.size = 3 },
+			func(n *TreeNode[int]) { n.size = 1 },
+
+			"size",
+		},
+	}
+	n := tree.Search(7, nil)
+	for i, test := range tests {
+		test.givenCorruption(n)
+		if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), test.wantError) {
+			t.Errorf("%d: Violation not reported, found: %v", i, err)
+		}
+		test.givenRepair(n)
+		if err := tree.Validate(); err != nil {
+			t.Errorf("%d: Repaired tree failed: %v", i, err)
+		}
+	}
+
+	// 7 goes below 1 without rebalancing
+	n.parent.left = nil
+	n.parent = tree.Search(1, nil)
+	n.parent.right = n
+	FixAllHeights(n)
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "order") {
+		t.Errorf("Order violation not reported, found: %v", err)
+	}
+	n.value = 1
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "unbalanced") {
+		t.Errorf("Balance violation not reported, found: %v", err)
+	}
+}
+
+func TestDump(t *testing.T) {
+	tree := NewOrdered[int]()
+	if tree.String() != "" {
+		t.Errorf("Empty tree dumped: %q", tree.String())
+	}
+	tree.SetDuplicates(CountDuplicates)
+	for _, n := range []int{5, 2, 9, 7, 1, 7} {
+		tree.Insert(n)
+	}
+	want := "    9\n        7 x2\n5\n    2\n        1\n"
+	var b strings.Builder
+	if err := tree.Dump(&b); err != nil || b.String() != want {
+		t.Errorf("Invalid dump, found:\n%s\nexpected:\n%s", b.String(), want)
+	}
+}
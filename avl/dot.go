@@ -0,0 +1,53 @@
+// dot.go
+
+package avl
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// dotEscaper makes labels safe inside DOT quoted strings
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// WriteDOT writes tree as a Graphviz DOT digraph, to render with e.g. "dot -Tsvg"
+//  label formats each item, fmt.Sprint if nil, nodes also show height h and balance factor b
+//  a point stands in for a missing child of a node with one child, so its side shows
+func (t Tree[T]) WriteDOT(w io.Writer, label func(T) string) error {
+	if label == nil {
+		label = func(v T) string {
+			return fmt.Sprint(v)
+		}
+	}
+	var b strings.Builder
+	b.WriteString("digraph avl {\n\tnode [shape=circle];\n")
+	if t.root != nil {
+		next := 0
+		t.writeDOT(&b, t.root, label, &next)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeDOT writes branch n in pre order, numbering nodes from next, returns number of n
+func (t Tree[T]) writeDOT(b *strings.Builder, n *TreeNode[T], label func(T) string, next *int) int {
+	id := *next
+	*next++
+	l := dotEscaper.Replace(label(n.value))
+	if n.count > 1 {
+		l += fmt.Sprintf(" x%d", n.count)
+	}
+	l += fmt.Sprintf(`\nh=%d b=%d`, n.height, IsBalanced(n))
+	fmt.Fprintf(b, "\tn%d [label=\"%s\"];\n", id, l)
+	for _, c := range []*TreeNode[T]{n.left, n.right} {
+		if c != nil {
+			fmt.Fprintf(b, "\tn%d -> n%d;\n", id, t.writeDOT(b, c, label, next))
+		} else if n.left != nil || n.right != nil {
+			fmt.Fprintf(b, "\tn%d [shape=point];\n\tn%d -> n%d;\n", *next, id, *next)
+			*next++
+		}
+	}
+	return id
+}
@@ -0,0 +1,33 @@
+// dot_test.go
+
+package avl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteDOT(t *testing.T) {
+	tree := NewOrdered[int]()
+	tree.SetDuplicates(CountDuplicates)
+	for _, n := range []int{5, 2, 9, 7, 7} {
+		tree.Insert(n)
+	}
+	want := `digraph avl {
+	node [shape=circle];
+	n0 [label="5\nh=2 b=-1"];
+	n1 [label="2\nh=0 b=0"];
+	n0 -> n1;
+	n2 [label="9\nh=1 b=1"];
+	n3 [label="7 x2\nh=0 b=0"];
+	n2 -> n3;
+	n4 [shape=point];
+	n2 -> n4;
+	n0 -> n2;
+}
+`
+	var b strings.Builder
+	if err := tree.WriteDOT(&b, nil); err != nil || b.String() != want {
+		t.Errorf("Invalid DOT, found:\n%s\nexpected:\n%s", b.String(), want)
+	}
+}
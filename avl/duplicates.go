@@ -0,0 +1,62 @@
+// duplicates.go
+
+package avl
+
+import "errors"
+
+// Duplicates - how Insert handles an item equal to one already in the tree
+type Duplicates uint8
+
+// Duplicate policies, set with SetDuplicates() before inserting
+const (
+	AllowDuplicates    Duplicates = iota // default, each equal item gets its own node, after those in tree
+	RejectDuplicates                     // new item is dropped, Add() returns ErrDuplicate
+	ReplaceDuplicates                    // new item replaces the one in tree
+	KeepFirstDuplicate                   // new item is dropped silently
+	CountDuplicates                      // multiset, equal items share one node counting them
+)
+
+// ErrDuplicate - returned by Add() for an item rejected by RejectDuplicates
+var ErrDuplicate = errors.New("avl: duplicate item")
+
+// SetDuplicates sets how equal items are inserted
+func (t *Tree[T]) SetDuplicates(d Duplicates) {
+	t.duplicates = d
+}
+
+// GetDuplicates returns how equal items are inserted
+func (t Tree[T]) GetDuplicates() Duplicates {
+	return t.duplicates
+}
+
+// insertDuplicate applies duplicate policy to newValue, equal to item of node x
+func (t *Tree[T]) insertDuplicate(x *TreeNode[T], newValue T) error {
+	switch t.duplicates {
+	case RejectDuplicates:
+		return ErrDuplicate
+	case ReplaceDuplicates:
+		x.value = newValue
+	case CountDuplicates:
+		x.count++
+		FixAllHeights(x) // sizes count copies
+	}
+	return nil
+}
+
+// deleteOne removes one copy of item of node z, the node itself once none are left
+func (t *Tree[T]) deleteOne(z *TreeNode[T]) {
+	if z != nil && z.count > 1 {
+		z.count--
+		FixAllHeights(z)
+		return
+	}
+	t.Delete(z)
+}
+
+// GetCount returns copies of item held by node, 0 for nil nodes
+func GetCount[T any](n *TreeNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.count
+}
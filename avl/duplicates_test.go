@@ -0,0 +1,116 @@
+// duplicates_test.go
+
+package avl
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+type tagged struct {
+	key int
+	tag string
+}
+
+func TestDuplicates(t *testing.T) {
+	given := []tagged{{5, "a"}, {2, "a"}, {5, "b"}, {9, "a"}, {5, "c"}, {2, "b"}}
+	tests := []struct {
+		givenPolicy Duplicates
+
+		wantArray      []tagged
+		wantErr        error
+		wantFinalArray []tagged // after Remove of key 5
+	}{
+		{
+			AllowDuplicates,
+
+			[]tagged{{2, "a"}, {2, "b"}, {5, "a"}, {5, "b"}, {5, "c"}, {9, "a"}},
+			nil,
+			[]tagged{{2, "a"}, {2, "b"}, {5, "b"}, {5, "c"}, {9, "a"}},
+		},
+		{
+			RejectDuplicates,
+
+			[]tagged{{2, "a"}, {5, "a"}, {9, "a"}},
+			ErrDuplicate,
+			[]tagged{{2, "a"}, {9, "a"}},
+		},
+		{
+			ReplaceDuplicates,
+
+			[]tagged{{2, "b"}, {5, "c"}, {9, "a"}},
+			nil,
+			[]tagged{{2, "b"}, {9, "a"}},
+		},
+		{
+			KeepFirstDuplicate,
+
+			[]tagged{{2, "a"}, {5, "a"}, {9, "a"}},
+			nil,
+			[]tagged{{2, "a"}, {9, "a"}},
+		},
+		{
+			CountDuplicates,
+
+			[]tagged{{2, "a"}, {2, "a"}, {5, "a"}, {5, "a"}, {5, "a"}, {9, "a"}},
+			nil,
+			[]tagged{{2, "a"}, {2, "a"}, {5, "a"}, {5, "a"}, {9, "a"}},
+		},
+	}
+
+	for i, test := range tests {
+		tree := NewCompare(func(a, b tagged) int {
+			return cmp.Compare(a.key, b.key)
+		})
+		tree.SetDuplicates(test.givenPolicy)
+		var err error
+		for _, n := range given {
+			if e := tree.Add(n); e != nil {
+				err = e
+			}
+		}
+		if err != test.wantErr {
+			t.Errorf("%d: Invalid error, found: %v, expected: %v", i, err, test.wantErr)
+		}
+
+		ch := make(chan tagged, 1)
+		go tree.InOrderTreeWalk(tree.GetRoot(), ch)
+		var walked []tagged
+		for y := range ch {
+			walked = append(walked, y)
+		}
+		if !slices.Equal(walked, test.wantArray) {
+			t.Errorf("%d: Invalid walk, found: %v, expected: %v", i, walked, test.wantArray)
+		}
+		if all := slices.Collect(tree.All()); !slices.Equal(all, test.wantArray) {
+			t.Errorf("%d: Invalid iteration, found: %v, expected: %v", i, all, test.wantArray)
+		}
+		if tree.Len() != len(test.wantArray) {
+			t.Errorf("%d: Invalid length, found: %d, expected: %d", i, tree.Len(), len(test.wantArray))
+		}
+
+		for k, n := range test.wantArray {
+			if s := tree.Select(k); s == nil || s.value != n {
+				t.Errorf("%d: Invalid select of %d, expected: %v", i, k, n)
+			}
+		}
+		if r := tree.Rank(tagged{key: 9}); r != len(test.wantArray)-1 {
+			t.Errorf("%d: Invalid rank, found: %d, expected: %d", i, r, len(test.wantArray)-1)
+		}
+		lo, hi := tagged{key: 0}, tagged{key: 10}
+		if got := tree.Range(lo, hi, IncludeBoth); !slices.Equal(got, test.wantArray) || len(got) != tree.CountRange(lo, hi, IncludeBoth) {
+			t.Errorf("%d: Invalid range, found: %v, expected: %v", i, got, test.wantArray)
+		}
+		checkAVL(t, tree.GetRoot())
+
+		tree.Remove(tagged{key: 5})
+		checkAVL(t, tree.GetRoot())
+		if all := slices.Collect(tree.All()); !slices.Equal(all, test.wantFinalArray) {
+			t.Errorf("%d: Invalid final iteration, found: %v, expected: %v", i, all, test.wantFinalArray)
+		}
+		if c := tree.Count(tagged{key: 5}); c != tree.CountRange(tagged{key: 5}, tagged{key: 5}, IncludeBoth) {
+			t.Errorf("%d: Invalid count, found: %d", i, c)
+		}
+	}
+}
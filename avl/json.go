@@ -0,0 +1,30 @@
+// json.go
+
+package avl
+
+import "encoding/json"
+
+// MarshalJSON encodes items of tree as an array in order, repeating copies
+func (t Tree[T]) MarshalJSON() ([]byte, error) {
+	items := make([]T, 0)
+	for x := range t.All() {
+		items = append(items, x)
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON replaces contents of tree with items of a JSON array in any order, as FromSlice()
+//  comparisons must be set first, else ErrNoComparison is returned
+//  numbers decode as float64 into Items, so the comparisons must handle those
+//  equal items follow the duplicate policy
+func (t *Tree[T]) UnmarshalJSON(data []byte) error {
+	if t.compare == nil {
+		return ErrNoComparison
+	}
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	t.FromSlice(items)
+	return nil
+}
@@ -0,0 +1,141 @@
+// map.go
+
+package avl
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Entry - a key and its value as stored in a Map
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// Map - sorted dictionary on an AVL tree
+//  the comparison only applies to keys, values are carried along
+type Map[K, V any] struct {
+	tree Tree[Entry[K, V]]
+}
+
+// NewMap returns an empty map ordered by a three-way comparison of keys
+//  if c is nil, default casts keys as type int
+func NewMap[K, V any](c Comparison[K]) *Map[K, V] {
+	m := &Map[K, V]{}
+	m.Init(c)
+	return m
+}
+
+// NewOrderedMap returns an empty map of ordered keys using cmp.Compare
+func NewOrderedMap[K cmp.Ordered, V any]() *Map[K, V] {
+	return NewMap[K, V](cmp.Compare[K])
+}
+
+// Init sets the key comparison func
+//  if c is nil, default casts keys as type int
+func (m *Map[K, V]) Init(c Comparison[K]) {
+	if c == nil {
+		c = func(a, b K) int {
+			return cmp.Compare(any(a).(int), any(b).(int))
+		}
+	}
+	m.tree.SetCompareTreeItem(func(a, b Entry[K, V]) int {
+		return c(a.Key, b.Key)
+	})
+}
+
+// search finds node holding key or nil if none
+func (m Map[K, V]) search(key K) *TreeNode[Entry[K, V]] {
+	return m.tree.Search(Entry[K, V]{Key: key}, nil)
+}
+
+// Put adds a key and value, replacing the value in place if key exists
+func (m *Map[K, V]) Put(key K, value V) {
+	if m.tree.compare == nil {
+		m.Init(nil)
+	}
+	if n := m.search(key); n != nil {
+		n.value.Value = value
+		return
+	}
+	m.tree.Insert(Entry[K, V]{Key: key, Value: value})
+}
+
+// Get returns value of key and whether it was found
+func (m Map[K, V]) Get(key K) (V, bool) {
+	if n := m.search(key); n != nil {
+		return n.value.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has returns whether key is in map
+func (m Map[K, V]) Has(key K) bool {
+	return m.search(key) != nil
+}
+
+// Delete removes key, returns whether it was found
+func (m *Map[K, V]) Delete(key K) bool {
+	n := m.search(key)
+	if n == nil {
+		return false
+	}
+	m.tree.Delete(n)
+	return true
+}
+
+// Len returns number of keys
+func (m Map[K, V]) Len() int {
+	return m.tree.Len()
+}
+
+// Keys returns all keys in order
+func (m Map[K, V]) Keys() []K {
+	keys := make([]K, 0, m.tree.Len())
+	for n := m.tree.GetTreeMinimum(); n != nil; n = m.tree.GetNext(n) {
+		keys = append(keys, n.value.Key)
+	}
+	return keys
+}
+
+// Values returns all values in order of their keys
+func (m Map[K, V]) Values() []V {
+	values := make([]V, 0, m.tree.Len())
+	for n := m.tree.GetTreeMinimum(); n != nil; n = m.tree.GetNext(n) {
+		values = append(values, n.value.Value)
+	}
+	return values
+}
+
+// Min returns lowest key and its value, false if map is empty
+func (m Map[K, V]) Min() (K, V, bool) {
+	return entryOf(m.tree.GetTreeMinimum())
+}
+
+// Max returns highest key and its value, false if map is empty
+func (m Map[K, V]) Max() (K, V, bool) {
+	return entryOf(m.tree.GetTreeMaximum())
+}
+
+// entryOf splits a node's entry, false if node is nil
+func entryOf[K, V any](n *TreeNode[Entry[K, V]]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.value.Key, n.value.Value, true
+}
+
+// All iterates over keys and values in order
+func (m Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := m.tree.GetTreeMinimum(); n != nil; n = m.tree.GetNext(n) {
+			if !yield(n.value.Key, n.value.Value) {
+				return
+			}
+		}
+	}
+}
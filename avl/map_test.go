@@ -0,0 +1,81 @@
+// map_test.go
+
+package avl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	for i, k := range []string{"kenny", "kyle", "eric", "chef", "stan", "timmy"} {
+		m.Put(k, i)
+	}
+	m.Put("eric", 22) // replaced in place
+	if !m.Delete("timmy") || m.Delete("butters") {
+		t.Errorf("Invalid delete result")
+	}
+
+	wantKeys := []string{"chef", "eric", "kenny", "kyle", "stan"}
+	wantValues := []int{3, 22, 0, 1, 4}
+	if m.Len() != len(wantKeys) {
+		t.Errorf("Invalid length, found: %d, expected: %d", m.Len(), len(wantKeys))
+	}
+	keys, values := m.Keys(), m.Values()
+	for x := range wantKeys {
+		if keys[x] != wantKeys[x] || values[x] != wantValues[x] {
+			t.Errorf("%d: Invalid entry, found: %s=%d, expected: %s=%d", x, keys[x], values[x], wantKeys[x], wantValues[x])
+		}
+	}
+
+	x := 0
+	for k, v := range m.All() {
+		if k != wantKeys[x] || v != wantValues[x] {
+			t.Errorf("%d: Invalid iteration, found: %s=%d, expected: %s=%d", x, k, v, wantKeys[x], wantValues[x])
+		}
+		x++
+		if x == 2 {
+			break
+		}
+	}
+
+	if v, ok := m.Get("eric"); !ok || v != 22 {
+		t.Errorf("Invalid get, found: %d", v)
+	}
+	if _, ok := m.Get("timmy"); ok || m.Has("timmy") || !m.Has("kyle") {
+		t.Errorf("Invalid membership")
+	}
+	if k, v, ok := m.Min(); !ok || k != "chef" || v != 3 {
+		t.Errorf("Invalid min, found: %s=%d", k, v)
+	}
+	if k, v, ok := m.Max(); !ok || k != "stan" || v != 4 {
+		t.Errorf("Invalid max, found: %s=%d", k, v)
+	}
+}
+
+func TestMapCustomKeys(t *testing.T) {
+	// case insensitive keys, zero value map defaults to int keys
+	m := NewMap[Item, string](func(a, b Item) int {
+		return strings.Compare(strings.ToLower(a.(string)), strings.ToLower(b.(string)))
+	})
+	m.Put("Kenny", "orange")
+	m.Put("KENNY", "parka")
+	if m.Len() != 1 {
+		t.Errorf("Invalid length, found: %d, expected: 1", m.Len())
+	}
+	if v, _ := m.Get("kenny"); v != "parka" {
+		t.Errorf("Invalid get, found: %s", v)
+	}
+
+	var ints Map[int, bool]
+	if _, _, ok := ints.Min(); ok || ints.Delete(1) {
+		t.Errorf("Empty map has entries")
+	}
+	for _, k := range []int{3, 1, 2} {
+		ints.Put(k, true)
+	}
+	if k, _, _ := ints.Min(); k != 1 {
+		t.Errorf("Invalid min, found: %d", k)
+	}
+}
@@ -0,0 +1,275 @@
+// persistent.go
+
+package avl
+
+import (
+	"cmp"
+	"iter"
+)
+
+// persistentNode of a Persistent tree, never changed once built
+//  no parent link, so it can be shared between versions
+type persistentNode[T any] struct {
+	value  T
+	left   *persistentNode[T]
+	right  *persistentNode[T]
+	height int // for AVL property
+	size   int // nodes in subtree
+}
+
+// Persistent - immutable AVL tree of items of type T
+//  Insert and Delete return a new tree, copying only the path to the change and sharing all other nodes,
+//  so older versions stay valid and any version can be read concurrently without locks
+//  equal items each get their own node, after those already in tree
+type Persistent[T any] struct {
+	root    *persistentNode[T]
+	compare Comparison[T]
+}
+
+// PersistentTree - persistent tree of Items, the interface{} API over the generic Persistent
+type PersistentTree = Persistent[Item]
+
+// NewPersistent returns an empty persistent tree using a three-way comparison func
+//  if c is nil, default casts items as type int
+func NewPersistent[T any](c Comparison[T]) Persistent[T] {
+	if c == nil {
+		c = func(a, b T) int {
+			return cmp.Compare(any(a).(int), any(b).(int))
+		}
+	}
+	return Persistent[T]{compare: c}
+}
+
+// NewOrderedPersistent returns an empty persistent tree of ordered items using cmp.Compare
+func NewOrderedPersistent[T cmp.Ordered]() Persistent[T] {
+	return NewPersistent(cmp.Compare[T])
+}
+
+// Snapshot returns the current version in O(1), unaffected by later changes
+func (p Persistent[T]) Snapshot() Persistent[T] {
+	return p
+}
+
+// Insert returns a new version with newValue added
+func (p Persistent[T]) Insert(newValue T) Persistent[T] {
+	p.root = p.insert(p.root, newValue)
+	return p
+}
+
+// Delete returns a new version without an item equal to k, or the same version if none
+func (p Persistent[T]) Delete(k T) Persistent[T] {
+	p.root, _ = p.delete(p.root, k)
+	return p
+}
+
+// Search returns the stored item equal to k, false if none
+func (p Persistent[T]) Search(k T) (T, bool) {
+	x := p.root
+	for x != nil {
+		c := p.compare(k, x.value)
+		if c == 0 {
+			return x.value, true
+		} else if c < 0 {
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Contains reports whether an item equal to k is in tree
+func (p Persistent[T]) Contains(k T) bool {
+	_, ok := p.Search(k)
+	return ok
+}
+
+// Min returns lowest item, false if tree is empty
+func (p Persistent[T]) Min() (T, bool) {
+	x := p.root
+	for x != nil && x.left != nil {
+		x = x.left
+	}
+	return persistentValueOf(x)
+}
+
+// Max returns highest item, false if tree is empty
+func (p Persistent[T]) Max() (T, bool) {
+	x := p.root
+	for x != nil && x.right != nil {
+		x = x.right
+	}
+	return persistentValueOf(x)
+}
+
+// Len returns number of items in tree
+func (p Persistent[T]) Len() int {
+	return persistentSize(p.root)
+}
+
+// GetTreeHeight helper return overall tree height, -1 if empty
+func (p Persistent[T]) GetTreeHeight() int {
+	return persistentHeight(p.root)
+}
+
+// All iterates over items in order
+func (p Persistent[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*persistentNode[T]
+		for x := p.root; x != nil || len(stack) > 0; x = x.right {
+			for ; x != nil; x = x.left {
+				stack = append(stack, x)
+			}
+			x = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(x.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward iterates over items in reverse order
+func (p Persistent[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*persistentNode[T]
+		for x := p.root; x != nil || len(stack) > 0; x = x.left {
+			for ; x != nil; x = x.right {
+				stack = append(stack, x)
+			}
+			x = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(x.value) {
+				return
+			}
+		}
+	}
+}
+
+// insert returns a copy of branch n with newValue added
+func (p Persistent[T]) insert(n *persistentNode[T], newValue T) *persistentNode[T] {
+	if n == nil {
+		return &persistentNode[T]{value: newValue, size: 1}
+	}
+	c := *n
+	if p.compare(newValue, n.value) < 0 {
+		c.left = p.insert(n.left, newValue)
+	} else {
+		c.right = p.insert(n.right, newValue)
+	}
+	return rebalance(&c)
+}
+
+// delete returns a copy of branch n without an item equal to k, false and n itself if none
+func (p Persistent[T]) delete(n *persistentNode[T], k T) (*persistentNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var ok bool
+	c := *n
+	if d := p.compare(k, n.value); d < 0 {
+		c.left, ok = p.delete(n.left, k)
+	} else if d > 0 {
+		c.right, ok = p.delete(n.right, k)
+	} else if n.left == nil {
+		return n.right, true
+	} else if n.right == nil {
+		return n.left, true
+	} else { // both children present, successor takes its place
+		var successor *persistentNode[T]
+		c.right, successor = deleteMin(n.right)
+		c.value = successor.value
+		ok = true
+	}
+	if !ok {
+		return n, false
+	}
+	return rebalance(&c), true
+}
+
+// deleteMin returns a copy of branch n without its lowest node, and that node
+func deleteMin[T any](n *persistentNode[T]) (*persistentNode[T], *persistentNode[T]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	c := *n
+	var lowest *persistentNode[T]
+	c.left, lowest = deleteMin(n.left)
+	return rebalance(&c), lowest
+}
+
+// rebalance restores AVL property of new node n, whose children may have changed
+//  rotations copy any shared child they change
+//  returns n, or its replacement if rotated
+func rebalance[T any](n *persistentNode[T]) *persistentNode[T] {
+	fixPersistent(n)
+	b := persistentHeight(n.left) - persistentHeight(n.right)
+	if b < -1 { // too right heavy, fix
+		if persistentHeight(n.right.left) > persistentHeight(n.right.right) {
+			// right child is left heavy so 2 rotations
+			r := *n.right
+			n.right = rotateRightPersistent(&r)
+		}
+		return rotateLeftPersistent(n)
+	} else if b > 1 { // too left heavy, fix
+		if persistentHeight(n.left.right) > persistentHeight(n.left.left) {
+			// left child is right heavy so 2 rotations
+			l := *n.left
+			n.left = rotateLeftPersistent(&l)
+		}
+		return rotateRightPersistent(n)
+	}
+	return n
+}
+
+// rotateLeftPersistent rotates new node n with a copy of its right child, returns the copy
+func rotateLeftPersistent[T any](n *persistentNode[T]) *persistentNode[T] {
+	y := *n.right
+	n.right = y.left
+	fixPersistent(n)
+	y.left = n
+	fixPersistent(&y)
+	return &y
+}
+
+// rotateRightPersistent rotates new node n with a copy of its left child, returns the copy
+func rotateRightPersistent[T any](n *persistentNode[T]) *persistentNode[T] {
+	x := *n.left
+	n.left = x.right
+	fixPersistent(n)
+	x.right = n
+	fixPersistent(&x)
+	return &x
+}
+
+// fixPersistent resets height and size of new node n from its children
+func fixPersistent[T any](n *persistentNode[T]) {
+	n.height = max(persistentHeight(n.left), persistentHeight(n.right)) + 1
+	n.size = persistentSize(n.left) + persistentSize(n.right) + 1
+}
+
+// persistentHeight returns height of node, -1 for nil nodes
+func persistentHeight[T any](n *persistentNode[T]) int {
+	if n == nil {
+		return -1
+	}
+	return n.height
+}
+
+// persistentSize returns number of nodes in subtree of n, 0 for nil nodes
+func persistentSize[T any](n *persistentNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// persistentValueOf returns item of node, false if node is nil
+func persistentValueOf[T any](n *persistentNode[T]) (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.value, true
+}
@@ -0,0 +1,124 @@
+// persistent_test.go
+
+package avl
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// checkPersistent verifies stored heights, sizes, order and balance below n, returns height
+func checkPersistent[T any](t *testing.T, p Persistent[T], n *persistentNode[T]) int {
+	if n == nil {
+		return -1
+	}
+	l := checkPersistent(t, p, n.left)
+	r := checkPersistent(t, p, n.right)
+	if (n.left != nil && p.compare(n.left.value, n.value) > 0) || (n.right != nil && p.compare(n.right.value, n.value) < 0) {
+		t.Fatalf("Out of order at %v", n.value)
+	}
+	h := max(l, r) + 1
+	if h != n.height || n.size != persistentSize(n.left)+persistentSize(n.right)+1 {
+		t.Fatalf("Bad height or size at %v", n.value)
+	}
+	if l-r > 1 || r-l > 1 {
+		t.Fatalf("Unbalanced at %v, left: %d, right: %d", n.value, l, r)
+	}
+	return h
+}
+
+func TestPersistent(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	p := NewOrderedPersistent[int]()
+	var versions []Persistent[int]
+	var contents [][]int
+	var model []int
+	for i := 0; i < 400; i++ {
+		n := r.Intn(100)
+		if i%3 == 2 {
+			if j, found := slices.BinarySearch(model, n); found {
+				model = slices.Delete(slices.Clone(model), j, j+1)
+			}
+			p = p.Delete(n)
+		} else {
+			j, _ := slices.BinarySearch(model, n)
+			model = slices.Insert(slices.Clone(model), j, n)
+			p = p.Insert(n)
+		}
+		checkPersistent(t, p, p.root)
+		versions = append(versions, p.Snapshot())
+		contents = append(contents, model)
+	}
+
+	// every old version is unchanged
+	for i, v := range versions {
+		if got := slices.Collect(v.All()); !slices.Equal(got, contents[i]) {
+			t.Fatalf("%d: Version changed, found: %v, expected: %v", i, got, contents[i])
+		}
+		if v.Len() != len(contents[i]) {
+			t.Fatalf("%d: Invalid length, found: %d, expected: %d", i, v.Len(), len(contents[i]))
+		}
+	}
+
+	want := contents[len(contents)-1]
+	back := slices.Collect(p.Backward())
+	slices.Reverse(back)
+	if !slices.Equal(back, want) {
+		t.Errorf("Invalid backward order, found: %v", back)
+	}
+	if m, ok := p.Min(); !ok || m != want[0] {
+		t.Errorf("Invalid minimum, found: %d, expected: %d", m, want[0])
+	}
+	if m, ok := p.Max(); !ok || m != want[len(want)-1] {
+		t.Errorf("Invalid maximum, found: %d, expected: %d", m, want[len(want)-1])
+	}
+	for n := range 100 {
+		_, found := slices.BinarySearch(want, n)
+		if v, ok := p.Search(n); ok != found || (ok && v != n) || p.Contains(n) != found {
+			t.Errorf("Invalid search of %d", n)
+		}
+	}
+	if same := p.Delete(-1); same.root != p.root {
+		t.Errorf("Delete of missing item copied tree")
+	}
+
+	var empty Persistent[int]
+	if _, ok := empty.Min(); ok || empty.Len() != 0 || empty.GetTreeHeight() != -1 {
+		t.Errorf("Empty tree has items")
+	}
+}
+
+func TestPersistentSnapshotReaders(t *testing.T) {
+	p := NewPersistent[Item](nil)
+	for n := range 100 {
+		p = p.Insert(n)
+	}
+	snap := p.Snapshot()
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				x := 0
+				for range snap.All() {
+					x++
+				}
+				if x != 100 {
+					t.Errorf("Snapshot changed, walked %d items", x)
+					return
+				}
+			}
+		}()
+	}
+	for n := range 100 {
+		p = p.Delete(n).Insert(n + 100)
+	}
+	wg.Wait()
+	if v, ok := p.Min(); !ok || v != 100 {
+		t.Errorf("Invalid minimum, found: %v", v)
+	}
+}
@@ -0,0 +1,100 @@
+// range_test.go
+
+package avl
+
+import "testing"
+
+func makeRangeTree() BinaryTree {
+	var tree BinaryTree
+	for x, n := range []Item{5, 2, 9, 7, 1, 3, 4, 24, 14, 34, -1, 12, 18, 10, 16} {
+		if x == 0 {
+			tree.Init(n, nil, nil)
+		} else {
+			tree.Insert(n)
+		}
+	}
+	return tree
+}
+
+func TestRange(t *testing.T) {
+	tree := makeRangeTree()
+
+	tests := []struct {
+		givenLow   Item
+		givenHigh  Item
+		givenBound Bound
+
+		wantArray []Item
+	}{
+		{3, 12, IncludeBoth, []Item{3, 4, 5, 7, 9, 10, 12}},
+		{3, 12, ExcludeBoth, []Item{4, 5, 7, 9, 10}},
+		{3, 12, IncludeLow, []Item{3, 4, 5, 7, 9, 10}},
+		{3, 12, IncludeHigh, []Item{4, 5, 7, 9, 10, 12}},
+		{6, 13, IncludeBoth, []Item{7, 9, 10, 12}}, // bounds not in tree
+		{6, 13, ExcludeBoth, []Item{7, 9, 10, 12}},
+		{-10, 0, IncludeBoth, []Item{-1}},
+		{30, 50, IncludeBoth, []Item{34}},
+		{40, 50, IncludeBoth, []Item{}},
+		{12, 3, IncludeBoth, []Item{}}, // backwards
+		{5, 5, IncludeBoth, []Item{5}},
+		{5, 5, IncludeLow, []Item{}},
+	}
+
+	for i, test := range tests {
+		items := tree.Range(test.givenLow, test.givenHigh, test.givenBound)
+		if len(items) != len(test.wantArray) {
+			t.Errorf("%d: Invalid range, found: %v, expected: %v", i, items, test.wantArray)
+			continue
+		}
+		for x := range items {
+			if items[x] != test.wantArray[x] {
+				t.Errorf("%d: Invalid order, found: %v, expected: %v", i, items, test.wantArray)
+				break
+			}
+		}
+		if c := tree.CountRange(test.givenLow, test.givenHigh, test.givenBound); c != len(test.wantArray) {
+			t.Errorf("%d: Invalid count, found: %d, expected: %d", i, c, len(test.wantArray))
+		}
+	}
+}
+
+func TestBounds(t *testing.T) {
+	tree := makeRangeTree()
+
+	tests := []struct {
+		givenItem Item
+
+		wantFloor      Item
+		wantCeiling    Item
+		wantUpperBound Item
+	}{
+		{6, 5, 7, 7},
+		{7, 7, 7, 9},
+		{-5, nil, -1, -1},
+		{-1, -1, -1, 1},
+		{34, 34, 34, nil},
+		{40, 34, nil, nil},
+	}
+
+	value := func(n *Node) Item {
+		if n == nil {
+			return nil
+		}
+		return n.value
+	}
+
+	for i, test := range tests {
+		if v := value(tree.Floor(test.givenItem)); v != test.wantFloor {
+			t.Errorf("%d: Invalid floor, found: %v, expected: %v", i, v, test.wantFloor)
+		}
+		if v := value(tree.Ceiling(test.givenItem)); v != test.wantCeiling {
+			t.Errorf("%d: Invalid ceiling, found: %v, expected: %v", i, v, test.wantCeiling)
+		}
+		if v := value(tree.LowerBound(test.givenItem)); v != test.wantCeiling {
+			t.Errorf("%d: Invalid lower bound, found: %v, expected: %v", i, v, test.wantCeiling)
+		}
+		if v := value(tree.UpperBound(test.givenItem)); v != test.wantUpperBound {
+			t.Errorf("%d: Invalid upper bound, found: %v, expected: %v", i, v, test.wantUpperBound)
+		}
+	}
+}
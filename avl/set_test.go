@@ -0,0 +1,70 @@
+// set_test.go
+
+package avl
+
+import (
+	"testing"
+
+	"github.com/PuppyKhan/jebe/collection"
+	"github.com/PuppyKhan/jebe/collection/collectiontest"
+)
+
+func TestOrderedCollection(t *testing.T) {
+	collectiontest.Run(t, func() collection.OrderedCollection[int] {
+		return NewOrdered[int]()
+	})
+}
+
+func TestDuplicatesAndEmpty(t *testing.T) {
+	tree := NewOrdered[int]()
+	if _, ok := tree.TryPeek(); ok {
+		t.Errorf("Empty tree has a peek")
+	}
+	if _, ok := tree.TryPop(); ok {
+		t.Errorf("Empty tree has a pop")
+	}
+	if tree.Count(5) != 0 || tree.Remove(5) {
+		t.Errorf("Empty tree has items")
+	}
+
+	for _, n := range []int{5, 2, 5, 9, 5, 2, 7} {
+		tree.Insert(n)
+	}
+	tests := []struct {
+		givenItem int
+
+		wantCount int
+	}{
+		{5, 3},
+		{2, 2},
+		{9, 1},
+		{4, 0},
+	}
+	for _, test := range tests {
+		if c := tree.Count(test.givenItem); c != test.wantCount {
+			t.Errorf("Invalid count of %d, found: %d, expected: %d", test.givenItem, c, test.wantCount)
+		}
+	}
+
+	if !tree.Remove(5) || tree.Count(5) != 2 || !tree.Contains(5) {
+		t.Errorf("Remove of one duplicate failed")
+	}
+	for _, want := range []int{2, 2, 5, 5, 7, 9} {
+		if n, ok := tree.TryPeek(); !ok || n != want {
+			t.Errorf("Invalid peek, found: %d, expected: %d", n, want)
+		}
+		if n, ok := tree.TryPop(); !ok || n != want {
+			t.Errorf("Invalid pop, found: %d, expected: %d", n, want)
+		}
+	}
+	if _, ok := tree.TryPop(); ok || tree.Len() != 0 {
+		t.Errorf("Emptied tree has items")
+	}
+}
+
+func TestPopEmpty(t *testing.T) {
+	var tree BinaryTree
+	if tree.PopTreeMinimum() != nil || tree.PopTreeMaximum() != nil || tree.Pop() != nil {
+		t.Errorf("Empty tree has items")
+	}
+}
@@ -0,0 +1,104 @@
+// binary_test.go
+
+package bst
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	tree := NewOrdered[int]()
+	tree.SetDuplicates(CountDuplicates)
+	for _, n := range []int{5, 2, 9, 7, 1, 8, 5, 5, 3} {
+		tree.Insert(n)
+	}
+	data, err := tree.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := NewOrdered[int]()
+	loaded.Insert(-5) // replaced
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.String() != tree.String() {
+		t.Errorf("Invalid shape, found:\n%s\nexpected:\n%s", loaded.String(), tree.String())
+	}
+	if loaded.GetDuplicates() != CountDuplicates || loaded.Count(5) != 3 {
+		t.Errorf("Invalid multiset, found: %v", slices.Collect(loaded.All()))
+	}
+}
+
+func TestGob(t *testing.T) {
+	tree := New[Item](nil, nil)
+	for _, n := range []int{5, 2, 9, 7, 1} {
+		tree.Insert(n)
+	}
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(tree); err != nil {
+		t.Fatal(err)
+	}
+	loaded := New[Item](nil, nil)
+	if err := gob.NewDecoder(&b).Decode(loaded); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.String() != tree.String() {
+		t.Errorf("Invalid shape, found:\n%s\nexpected:\n%s", loaded.String(), tree.String())
+	}
+
+	// a zero value has no comparison to check items against
+	words := NewCompare(func(a, b Item) int {
+		return strings.Compare(a.(string), b.(string))
+	})
+	words.Insert("kyle")
+	words.Insert("eric")
+	b.Reset()
+	if err := gob.NewEncoder(&b).Encode(words); err != nil {
+		t.Fatal(err)
+	}
+	var zero BinaryTree
+	if err := gob.NewDecoder(&b).Decode(&zero); err != ErrNoComparison || zero.GetRoot() != nil {
+		t.Errorf("Missing comparison not reported, found: %v", err)
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7, 1} {
+		tree.Insert(n)
+	}
+	data, _ := tree.MarshalBinary()
+	// root flags, after header, policy and node count
+	leaf := slices.Clone(data)
+	leaf[8] = 0
+
+	tests := []struct {
+		givenData    []byte
+		givenCompare Comparison[int]
+
+		wantError string
+	}{
+		{data[:5], nil, "corrupt"},
+		{append([]byte("jebe\x01\x03"), data[6:]...), nil, "kind"},
+		{data[:len(data)-1], nil, "corrupt"},
+		{leaf, nil, "corrupt"},
+		{data, func(a, b int) int { return b - a }, "out of order"},
+	}
+	for i, test := range tests {
+		loaded := NewOrdered[int]()
+		if test.givenCompare != nil {
+			loaded = NewCompare(test.givenCompare)
+		}
+		loaded.Insert(42)
+		if err := loaded.UnmarshalBinary(test.givenData); err == nil || !strings.Contains(err.Error(), test.wantError) {
+			t.Errorf("%d: Invalid error, found: %v, expected: %s", i, err, test.wantError)
+		}
+		if got := slices.Collect(loaded.All()); !slices.Equal(got, []int{42}) {
+			t.Errorf("%d: Tree changed by invalid data, found: %v", i, got)
+		}
+	}
+}
@@ -2,44 +2,125 @@
 
 package bst
 
+import (
+	"cmp"
+
+	"github.com/PuppyKhan/jebe/collection"
+)
+
 // Item - the type to be sorted
-type Item interface{}
-
-// Node of a binary tree
-type Node struct {
-	value  Item
-	left   *Node
-	right  *Node
-	parent *Node // doubly linked
+type Item = collection.Item
+
+// TreeNode of a binary tree holding items of type T
+type TreeNode[T any] struct {
+	value  T
+	left   *TreeNode[T]
+	right  *TreeNode[T]
+	parent *TreeNode[T] // doubly linked
+	count  int          // copies of value, more than 1 only with CountDuplicates
 }
 
+// Node of a binary tree of Items
+type Node = TreeNode[Item]
+
+// Prioritize - custom comparison for prioritizing tree items of type T
+//  basic sort would need "a < b" ("a > b" for high to low)
+type Prioritize[T any] = collection.Prioritize[T]
+
+// Equivalence - custom comparison for equality of tree items of type T, "a == b"
+type Equivalence[T any] = collection.Equivalence[T]
+
 // PrioritizeTreeItem - custom comparison for prioritizing tree items
 //  basic sort would need "a < b" ("a > b" for hight to low)
-type PrioritizeTreeItem func(a, b Item) bool
+type PrioritizeTreeItem = Prioritize[Item]
 
 // EquivalenceTreeItem - custom comparison for equality of tree items, "a == b"
 //  needed for search
-type EquivalenceTreeItem func(a, b Item) bool
+type EquivalenceTreeItem = Equivalence[Item]
+
+// Comparison - custom three-way comparison of tree items of type T
+//  negative for "a < b", 0 for "a == b", positive for "a > b", like cmp.Compare
+type Comparison[T any] = collection.Comparison[T]
+
+// CompareTreeItem - custom three-way comparison of tree items
+//  replaces a PrioritizeTreeItem and EquivalenceTreeItem pair
+type CompareTreeItem = Comparison[Item]
+
+// Codec - converts tree items of type T to and from bytes for MarshalBinary
+type Codec[T any] = collection.Codec[T]
+
+// Tree holds the root of the tree and its comparison functions, for items of type T
+type Tree[T any] struct {
+	root       *TreeNode[T]
+	lesser     Prioritize[T]
+	equals     Equivalence[T]
+	compare    Comparison[T] // used for all ordering, derived if not set directly
+	duplicates Duplicates    // how equal items are inserted
+	codec      Codec[T]      // items for MarshalBinary, GobCodec if not set
+}
+
+// BinaryTree - tree of Items, the interface{} API over the generic Tree
+type BinaryTree = Tree[Item]
+
+// New returns an empty tree using the given comparison funcs
+//  if either is nil, default casts items as type int
+func New[T any](a Prioritize[T], b Equivalence[T]) *Tree[T] {
+	t := &Tree[T]{}
+	t.setComparisons(a, b)
+	return t
+}
+
+// NewCompare returns an empty tree using a three-way comparison func
+//  if c is nil, default casts items as type int
+func NewCompare[T any](c Comparison[T]) *Tree[T] {
+	t := &Tree[T]{}
+	t.setCompare(c)
+	return t
+}
 
-// BinaryTree holds the root of the tree and its comparison function
-type BinaryTree struct {
-	root   *Node
-	lesser PrioritizeTreeItem
-	equals EquivalenceTreeItem
+// NewOrdered returns an empty tree of ordered items using cmp.Compare
+func NewOrdered[T cmp.Ordered]() *Tree[T] {
+	return NewCompare(cmp.Compare[T])
 }
 
 // MakeNode puts Item into a Node, sets parent, returns pointer
-func MakeNode(val Item, p *Node) *Node {
-	return &Node{
+func MakeNode[T any](val T, p *TreeNode[T]) *TreeNode[T] {
+	return &TreeNode[T]{
 		value:  val,
 		left:   nil,
 		right:  nil,
 		parent: p,
+		count:  1,
 	}
 }
 
 // Init sets both root node and comparison func
-func (t *BinaryTree) Init(root Item, a PrioritizeTreeItem, b EquivalenceTreeItem) {
+func (t *Tree[T]) Init(root T, a Prioritize[T], b Equivalence[T]) {
+	t.setComparisons(a, b)
+
+	// Insert() uses lesser() methods, so SetPrioritizeTreeItem() must run first
+	t.Insert(root)
+}
+
+// InitCompare sets both root node and three-way comparison func
+func (t *Tree[T]) InitCompare(root T, c Comparison[T]) {
+	t.setCompare(c)
+
+	// Insert() uses compare() methods, so setCompare() must run first
+	t.Insert(root)
+}
+
+// setCompare sets three-way comparison func, defaulting nil to ints
+func (t *Tree[T]) setCompare(c Comparison[T]) {
+	if c == nil {
+		t.setComparisons(nil, nil)
+	} else {
+		t.SetCompareTreeItem(c)
+	}
+}
+
+// setComparisons sets both comparison funcs, defaulting nil ones to ints
+func (t *Tree[T]) setComparisons(a Prioritize[T], b Equivalence[T]) {
 	if a == nil {
 		t.SetLTIntPrioritizeTreeItem()
 	} else {
@@ -50,63 +131,108 @@ func (t *BinaryTree) Init(root Item, a PrioritizeTreeItem, b EquivalenceTreeItem
 	} else {
 		t.SetEquivalenceTreeItem(b)
 	}
-
-	// Insert() uses lesser() methods, so SetPrioritizeTreeItem() must run first
-	t.Insert(root)
 }
 
 // SetPrioritizeTreeItem - "a < b" or whatever comparison is needed
-func (t *BinaryTree) SetPrioritizeTreeItem(a PrioritizeTreeItem) {
+func (t *Tree[T]) SetPrioritizeTreeItem(a Prioritize[T]) {
 	t.lesser = a
+	t.deriveCompare()
 }
 
 // SetLTIntPrioritizeTreeItem - default "a < b" as ints
-func (t *BinaryTree) SetLTIntPrioritizeTreeItem() {
-	t.lesser = func(a, b Item) bool {
-		return a.(int) < b.(int)
+func (t *Tree[T]) SetLTIntPrioritizeTreeItem() {
+	t.lesser = func(a, b T) bool {
+		return any(a).(int) < any(b).(int)
 	}
+	t.deriveCompare()
 }
 
 // SetEquivalenceTreeItem - "a == b" or whatever comparison is needed
-func (t *BinaryTree) SetEquivalenceTreeItem(b EquivalenceTreeItem) {
+func (t *Tree[T]) SetEquivalenceTreeItem(b Equivalence[T]) {
 	t.equals = b
+	t.deriveCompare()
 }
 
 // SetEqIntEquivalenceTreeItem - default "a == b" as ints
-func (t *BinaryTree) SetEqIntEquivalenceTreeItem() {
-	t.equals = func(a, b Item) bool {
-		return a.(int) == b.(int)
+func (t *Tree[T]) SetEqIntEquivalenceTreeItem() {
+	t.equals = func(a, b T) bool {
+		return any(a).(int) == any(b).(int)
+	}
+	t.deriveCompare()
+}
+
+// SetCompareTreeItem - three-way comparison such as cmp.Compare or strings.Compare
+//  also derives lesser and equals from it
+func (t *Tree[T]) SetCompareTreeItem(c Comparison[T]) {
+	t.compare = c
+	t.lesser = func(a, b T) bool {
+		return c(a, b) < 0
+	}
+	t.equals = func(a, b T) bool {
+		return c(a, b) == 0
 	}
 }
 
-// Insert a new Item to a tree
-func (t *BinaryTree) Insert(newValue Item) {
-	var y *Node
+// deriveCompare adapts lesser and equals into a three-way comparison
+func (t *Tree[T]) deriveCompare() {
+	lesser, equals := t.lesser, t.equals
+	if equals == nil { // only lesser set, items are equal when neither is less
+		equals = func(a, b T) bool {
+			return !lesser(a, b) && !lesser(b, a)
+		}
+	}
+	t.compare = func(a, b T) int {
+		if lesser(a, b) {
+			return -1
+		} else if equals(a, b) {
+			return 0
+		}
+		return 1
+	}
+}
+
+// Insert a new Item to a tree, following its duplicate policy
+func (t *Tree[T]) Insert(newValue T) {
+	t.Add(newValue)
+}
+
+// Add a new Item to a tree, following its duplicate policy
+//  returns ErrDuplicate if an equal item is in tree and policy is RejectDuplicates
+func (t *Tree[T]) Add(newValue T) error {
+	var y *TreeNode[T]
 	x := t.root
-	z := MakeNode(newValue, nil)
+	less := false
 	for x != nil {
 		y = x
-		if t.lesser(z.value, x.value) {
+		c := t.compare(newValue, x.value)
+		if c == 0 && t.duplicates != AllowDuplicates {
+			return t.insertDuplicate(x, newValue)
+		}
+		less = c < 0
+		if less {
 			x = x.left
 		} else {
 			x = x.right
 		}
 	}
+	z := MakeNode(newValue, nil)
 	z.parent = y
 	if y == nil {
 		t.root = z // tree was empty
-	} else if t.lesser(z.value, y.value) {
+	} else if less {
 		y.left = z
 	} else {
 		y.right = z
 	}
+	return nil
 }
 
 // InsertRecursive a new Item to a tree
-func (t *BinaryTree) InsertRecursive(branchRoot, newValue *Node) *Node {
+//  equal items always get their own node, whatever the duplicate policy
+func (t *Tree[T]) InsertRecursive(branchRoot, newValue *TreeNode[T]) *TreeNode[T] {
 	if branchRoot == nil {
 		return newValue
-	} else if t.lesser(newValue.value, branchRoot.value) {
+	} else if t.compare(newValue.value, branchRoot.value) < 0 {
 		if branchRoot.left == nil {
 			newValue.parent = branchRoot
 			branchRoot.left = t.InsertRecursive(branchRoot.left, newValue)
@@ -126,52 +252,66 @@ func (t *BinaryTree) InsertRecursive(branchRoot, newValue *Node) *Node {
 }
 
 // GetRoot node helper
-func (t BinaryTree) GetRoot() *Node {
+func (t Tree[T]) GetRoot() *TreeNode[T] {
 	return t.root
 }
 
 // InOrderTreeWalk does left, current, right
+//  follows links rather than comparing items, so equal items are all sent
 //  closes channel when done
-func (t BinaryTree) InOrderTreeWalk(n *Node, c chan Item) {
-	x := n
-	var last *Node
-	for x != nil {
-		if x.left != nil && (last == nil || t.lesser(last.value, x.left.value)) {
-			x = x.left
-		} else {
-			if last != x && (last == nil || t.lesser(last.value, x.value)) {
-				c <- x.value
-				last = x
-			}
-			if x.right != nil && (last == nil || t.lesser(last.value, x.right.value)) {
-				x = x.right
-			} else {
-				x = x.parent
+func (t Tree[T]) InOrderTreeWalk(n *TreeNode[T], c chan T) {
+	if n != nil {
+		stop := n.parent
+		last, x := stop, n // last is node x was reached from
+		for x != stop {
+			var next *TreeNode[T]
+			if last == x.parent && x.left != nil { // came down, go left first
+				next = x.left
+			} else if last == x.right && x.right != nil { // came up from right, done here
+				next = x.parent
+			} else { // left side done
+				for i := 0; i < x.count; i++ {
+					c <- x.value
+				}
+				if x.right != nil {
+					next = x.right
+				} else {
+					next = x.parent
+				}
 			}
+			last, x = x, next
 		}
 	}
 	close(c)
 }
 
 // InOrderTreeWalkRecursive does left, current, right
-//  closes channel when done
-//  doesn't close channel if called on nil branch
-func (t BinaryTree) InOrderTreeWalkRecursive(n *Node, c chan Item) {
+//  closes channel when done, even if called on nil branch
+func (t Tree[T]) InOrderTreeWalkRecursive(n *TreeNode[T], c chan T) {
+	t.inOrderTreeWalkRecursive(n, c)
+	close(c)
+}
+
+// inOrderTreeWalkRecursive sends branch n to channel without closing it
+func (t Tree[T]) inOrderTreeWalkRecursive(n *TreeNode[T], c chan T) {
 	if n != nil {
-		t.InOrderTreeWalkRecursive(n.left, c)
-		c <- n.value
-		t.InOrderTreeWalkRecursive(n.right, c)
-		if t.equals(n.value, t.root.value) {
-			close(c)
+		t.inOrderTreeWalkRecursive(n.left, c)
+		for i := 0; i < n.count; i++ {
+			c <- n.value
 		}
+		t.inOrderTreeWalkRecursive(n.right, c)
 	}
 }
 
 // SearchRecursive to find node with Item in current branch or nil if none
-func (t BinaryTree) SearchRecursive(k Item, current *Node) *Node {
-	if current == nil || t.equals(current.value, k) {
+func (t Tree[T]) SearchRecursive(k T, current *TreeNode[T]) *TreeNode[T] {
+	if current == nil {
 		return current
-	} else if t.lesser(k, current.value) {
+	}
+	c := t.compare(k, current.value)
+	if c == 0 {
+		return current
+	} else if c < 0 {
 		return t.Search(k, current.left)
 	} else {
 		return t.Search(k, current.right)
@@ -179,14 +319,17 @@ func (t BinaryTree) SearchRecursive(k Item, current *Node) *Node {
 }
 
 // Search to find node with Item in current branch or nil if none
-func (t BinaryTree) Search(k Item, current *Node) *Node {
+func (t Tree[T]) Search(k T, current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	if x == nil {
 		x = t.root
 	}
 	kval := k
-	for x != nil && !t.equals(x.value, kval) {
-		if t.lesser(kval, x.value) {
+	for x != nil {
+		c := t.compare(kval, x.value)
+		if c == 0 {
+			break
+		} else if c < 0 {
 			x = x.left
 		} else {
 			x = x.right
@@ -196,7 +339,7 @@ func (t BinaryTree) Search(k Item, current *Node) *Node {
 }
 
 // GetMinimum finds lowest value
-func (t BinaryTree) GetMinimum(current *Node) *Node {
+func (t Tree[T]) GetMinimum(current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	for x != nil && x.left != nil {
 		x = x.left
@@ -205,7 +348,7 @@ func (t BinaryTree) GetMinimum(current *Node) *Node {
 }
 
 // GetMaximum finds highest value
-func (t BinaryTree) GetMaximum(current *Node) *Node {
+func (t Tree[T]) GetMaximum(current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	for x != nil && x.right != nil {
 		x = x.right
@@ -214,7 +357,7 @@ func (t BinaryTree) GetMaximum(current *Node) *Node {
 }
 
 // GetNext finds successor in order
-func (t BinaryTree) GetNext(current *Node) *Node {
+func (t Tree[T]) GetNext(current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	if x == nil {
 		return nil
@@ -231,7 +374,7 @@ func (t BinaryTree) GetNext(current *Node) *Node {
 }
 
 // GetPrevious finds predecessor in order
-func (t BinaryTree) GetPrevious(current *Node) *Node {
+func (t Tree[T]) GetPrevious(current *TreeNode[T]) *TreeNode[T] {
 	x := current
 	if x == nil {
 		return nil
@@ -249,7 +392,7 @@ func (t BinaryTree) GetPrevious(current *Node) *Node {
 
 // Transplant switches branch u with v
 //  Does _NOT_ update sub branches
-func (t *BinaryTree) Transplant(u, v *Node) {
+func (t *Tree[T]) Transplant(u, v *TreeNode[T]) {
 	if u.parent == nil {
 		t.root = v
 	} else if u == u.parent.left {
@@ -262,8 +405,8 @@ func (t *BinaryTree) Transplant(u, v *Node) {
 	}
 }
 
-// Delete removes a node and adjusts tree accordingly
-func (t *BinaryTree) Delete(z *Node) {
+// Delete removes a node, with all its copies, and adjusts tree accordingly
+func (t *Tree[T]) Delete(z *TreeNode[T]) {
 	if z == nil {
 		return
 	}
@@ -3,6 +3,7 @@
 package bst
 
 import (
+	"slices"
 	"strings"
 	"testing"
 )
@@ -208,3 +209,149 @@ func TestBinarySearchTreeWalkRecursives(t *testing.T) {
 		}
 	}
 }
+
+func TestGenericTree(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7, 1, 3, 4, 24, 14, 34, -1, 12, 18, 10, 16} {
+		tree.Insert(n)
+	}
+	tree.Delete(tree.Search(24, nil))
+
+	want := []int{-1, 1, 2, 3, 4, 5, 7, 9, 10, 12, 14, 16, 18, 34}
+	ch := make(chan int, 1)
+	go tree.InOrderTreeWalk(tree.GetRoot(), ch)
+	x := 0
+	for y := range ch {
+		if y != want[x] {
+			t.Errorf("Invalid order, found: %d, expected: %d", y, want[x])
+			return
+		}
+		x++
+	}
+	if x != len(want) {
+		t.Errorf("Walked %d items, expected: %d", x, len(want))
+	}
+	if tree.Search(24, nil) != nil {
+		t.Errorf("Found deleted item 24")
+	}
+
+	// custom item type, no assertions needed in comparisons
+	type person struct {
+		name string
+		age  int
+	}
+	var people Tree[person]
+	people.Init(person{"kenny", 9}, func(a, b person) bool {
+		return a.age < b.age
+	}, func(a, b person) bool {
+		return a.age == b.age
+	})
+	people.Insert(person{"chef", 40})
+	people.Insert(person{"timmy", 8})
+	if n := people.Search(person{age: 40}, nil); n == nil || n.value.name != "chef" {
+		t.Errorf("Search by age failed")
+	}
+}
+
+func TestCompareTree(t *testing.T) {
+	tests := []struct {
+		givenArray       []Item
+		givenCompareFunc func(a, b Item) int
+		givenDeletables  []Item
+
+		wantFinalArray []Item
+	}{
+		{
+			[]Item{5, 2, 9, 7, 1, 3, 4, 24, 14, 34, -1, 12, 18, 10, 16},
+			nil, // default int sort
+			[]Item{24, -1, 5},
+
+			[]Item{1, 2, 3, 4, 7, 9, 10, 12, 14, 16, 18, 34},
+		},
+		{
+			[]Item{"kenny", "kyle", "eric", "chef", "stan", "timmy"},
+			func(a, b Item) int {
+				return strings.Compare(a.(string), b.(string))
+			},
+			[]Item{"timmy", "kenny"},
+
+			[]Item{"chef", "eric", "kyle", "stan"},
+		},
+		{
+			[]Item{"kenny", "kyle", "eric", "chef", "stan", "timmy"},
+			func(a, b Item) int {
+				return strings.Compare(b.(string), a.(string)) // reverse sort
+			},
+			[]Item{"chef"},
+
+			[]Item{"timmy", "stan", "kyle", "kenny", "eric"},
+		},
+	}
+
+	for i, test := range tests {
+		var tree BinaryTree
+
+		for x, n := range test.givenArray {
+			if x == 0 {
+				tree.InitCompare(n, test.givenCompareFunc)
+			} else {
+				tree.Insert(n)
+			}
+		}
+
+		for _, n := range test.givenDeletables {
+			tree.Delete(tree.Search(n, nil))
+		}
+
+		ch := make(chan Item, 1)
+		go tree.InOrderTreeWalk(tree.GetRoot(), ch)
+
+		x := 0
+		for y := range ch {
+			// lesser and equals are derived from the comparison
+			if !tree.equals(y, test.wantFinalArray[x]) || (x > 0 && !tree.lesser(test.wantFinalArray[x-1], y)) {
+				t.Errorf("%d: Invalid order, found: %v, expected: %v", i, y, test.wantFinalArray[x])
+				return
+			}
+			x++
+		}
+		if x != len(test.wantFinalArray) {
+			t.Errorf("%d: Walked %d items, expected: %d", i, x, len(test.wantFinalArray))
+		}
+	}
+
+	// comparison derived from lesser and equals
+	tree := New(func(a, b string) bool {
+		return a < b
+	}, func(a, b string) bool {
+		return a == b
+	})
+	for _, s := range []string{"b", "a", "c"} {
+		tree.Insert(s)
+	}
+	if tree.compare("a", "b") >= 0 || tree.compare("b", "b") != 0 || tree.compare("c", "b") <= 0 {
+		t.Errorf("Invalid derived comparison")
+	}
+}
+
+func TestLesserOnlyTree(t *testing.T) {
+	var tree BinaryTree
+	tree.SetPrioritizeTreeItem(func(a, b Item) bool {
+		return a.(int) < b.(int)
+	})
+	for _, n := range []Item{5, 2, 9, 2, 7} {
+		tree.Insert(n)
+	}
+	ch := make(chan Item, 1)
+	go tree.InOrderTreeWalk(tree.GetRoot(), ch)
+	var got []Item
+	for y := range ch {
+		got = append(got, y)
+	}
+	if want := []Item{2, 2, 5, 7, 9}; !slices.Equal(got, want) {
+		t.Errorf("Invalid order, found: %v, expected: %v", got, want)
+	}
+	if n := tree.Search(7, nil); n == nil || n.value != 7 {
+		t.Errorf("Invalid search, found: %v", n)
+	}
+}
@@ -0,0 +1,59 @@
+// build.go
+
+package bst
+
+import (
+	"errors"
+	"slices"
+)
+
+// ErrUnsorted - returned by FromSorted() for items out of order
+var ErrUnsorted = errors.New("bst: items out of order")
+
+// FromSorted replaces contents of tree with items, building it perfectly balanced in O(n)
+//  items must be in order of the tree's comparison, else tree is left unchanged and ErrUnsorted returned
+//  equal items follow the duplicate policy, as if inserted in order
+func (t *Tree[T]) FromSorted(items []T) error {
+	for i := 1; i < len(items); i++ {
+		if t.compare(items[i-1], items[i]) > 0 {
+			return ErrUnsorted
+		}
+	}
+	nodes := t.sortedNodes(items)
+	t.root = buildBalanced(nodes, nil)
+	return nil
+}
+
+// FromSlice replaces contents of tree with items in any order, sorting a copy first
+func (t *Tree[T]) FromSlice(items []T) {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, t.compare)
+	t.FromSorted(sorted)
+}
+
+// sortedNodes makes a node per sorted item, merging equal items by the duplicate policy
+func (t *Tree[T]) sortedNodes(items []T) []*TreeNode[T] {
+	nodes := make([]*TreeNode[T], 0, len(items))
+	for _, v := range items {
+		if last := len(nodes) - 1; last >= 0 && t.duplicates != AllowDuplicates && t.compare(nodes[last].value, v) == 0 {
+			t.insertDuplicate(nodes[last], v) // not linked yet, so nothing to fix above it
+			continue
+		}
+		nodes = append(nodes, MakeNode(v, nil))
+	}
+	return nodes
+}
+
+// buildBalanced links sorted nodes into a branch under parent, middle node as its root
+//  returns branch root, nil if no nodes
+func buildBalanced[T any](nodes []*TreeNode[T], parent *TreeNode[T]) *TreeNode[T] {
+	if len(nodes) == 0 {
+		return nil
+	}
+	m := len(nodes) / 2
+	n := nodes[m]
+	n.parent = parent
+	n.left = buildBalanced(nodes[:m], n)
+	n.right = buildBalanced(nodes[m+1:], n)
+	return n
+}
@@ -0,0 +1,102 @@
+// build_test.go
+
+package bst
+
+import (
+	"math/bits"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestFromSorted(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 3, 7, 100, 1000} {
+		items := make([]int, size)
+		for i := range items {
+			items[i] = i * 2
+		}
+		tree := NewOrdered[int]()
+		tree.Insert(-5) // replaced
+		if err := tree.FromSorted(items); err != nil {
+			t.Fatalf("%d: %v", size, err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("%d: %v", size, err)
+		}
+		if got := slices.Collect(tree.All()); !slices.Equal(got, items) {
+			t.Errorf("%d: Invalid order, found: %v", size, got)
+		}
+		// perfectly balanced, height is floor(log2(size))
+		if h, want := height(tree.GetRoot()), bits.Len(uint(size))-1; h != want {
+			t.Errorf("%d: Invalid height, found: %d, expected: %d", size, h, want)
+		}
+		if tree.Len() != size {
+			t.Errorf("%d: Invalid length, found: %d", size, tree.Len())
+		}
+	}
+
+	tree := NewOrdered[int]()
+	tree.Insert(1)
+	if err := tree.FromSorted([]int{1, 3, 2}); err != ErrUnsorted {
+		t.Errorf("Unsorted items not reported, found: %v", err)
+	}
+	if tree.Len() != 1 {
+		t.Errorf("Tree changed by unsorted items")
+	}
+}
+
+func TestFromSortedDuplicates(t *testing.T) {
+	tests := []struct {
+		givenPolicy Duplicates
+
+		wantArray []int
+		wantNodes int
+	}{
+		{AllowDuplicates, []int{1, 1, 2, 2, 2, 3, 3}, 7},
+		{RejectDuplicates, []int{1, 2, 3}, 3},
+		{KeepFirstDuplicate, []int{1, 2, 3}, 3},
+		{CountDuplicates, []int{1, 1, 2, 2, 2, 3, 3}, 3},
+	}
+	for _, test := range tests {
+		tree := NewOrdered[int]()
+		tree.SetDuplicates(test.givenPolicy)
+		tree.FromSorted([]int{1, 1, 2, 2, 2, 3, 3})
+		if err := tree.Validate(); err != nil {
+			t.Errorf("%d: %v", test.givenPolicy, err)
+		}
+		if got := slices.Collect(tree.All()); !slices.Equal(got, test.wantArray) {
+			t.Errorf("%d: Invalid order, found: %v, expected: %v", test.givenPolicy, got, test.wantArray)
+		}
+		nodes := 0
+		for n := tree.GetMinimum(tree.GetRoot()); n != nil; n = tree.GetNext(n) {
+			nodes++
+		}
+		if nodes != test.wantNodes {
+			t.Errorf("%d: Invalid node count, found: %d, expected: %d", test.givenPolicy, nodes, test.wantNodes)
+		}
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	items := r.Perm(500)
+	tree := NewOrdered[int]()
+	tree.FromSlice(items)
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.IsSorted(slices.Collect(tree.All())) || tree.Len() != len(items) {
+		t.Errorf("Invalid tree from slice")
+	}
+	if slices.IsSorted(items) {
+		t.Errorf("Given slice was sorted in place")
+	}
+}
+
+// height of branch n, -1 for nil
+func height[T any](n *TreeNode[T]) int {
+	if n == nil {
+		return -1
+	}
+	return max(height(n.left), height(n.right)) + 1
+}
@@ -0,0 +1,91 @@
+// debug.go
+
+package bst
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Validate checks ordering, parent links and copy counts against the duplicate policy
+//  returns an error naming the first offending node, nil if tree is valid
+func (t Tree[T]) Validate() error {
+	if t.root == nil {
+		return nil
+	}
+	if t.root.parent != nil {
+		return fmt.Errorf("root %v has a parent", t.root.value)
+	}
+	var last *TreeNode[T]
+	return t.validate(t.root, &last)
+}
+
+// validate checks branch n in order, last being the node visited before it
+func (t Tree[T]) validate(n *TreeNode[T], last **TreeNode[T]) error {
+	if n == nil {
+		return nil
+	}
+	for _, c := range []*TreeNode[T]{n.left, n.right} {
+		if c != nil && c.parent != n {
+			return fmt.Errorf("node %v has wrong parent link", c.value)
+		}
+	}
+	if err := t.validate(n.left, last); err != nil {
+		return err
+	}
+	if err := t.validateNode(*last, n); err != nil {
+		return err
+	}
+	*last = n
+	return t.validate(n.right, last)
+}
+
+// validateNode checks count of n and its order after prev, nil if n is first
+func (t Tree[T]) validateNode(prev, n *TreeNode[T]) error {
+	if n.count < 1 || (n.count > 1 && t.duplicates != CountDuplicates) {
+		return fmt.Errorf("node %v has %d copies", n.value, n.count)
+	}
+	if prev == nil {
+		return nil
+	}
+	c := t.compare(prev.value, n.value)
+	if c > 0 {
+		return fmt.Errorf("node %v is out of order after %v", n.value, prev.value)
+	}
+	if c == 0 && t.duplicates != AllowDuplicates {
+		return fmt.Errorf("node %v duplicates %v", n.value, prev.value)
+	}
+	return nil
+}
+
+// Dump writes tree sideways, root on the left and highest item on top, one node per line
+//  copies are shown as "x3" after the item
+func (t Tree[T]) Dump(w io.Writer) error {
+	return dump(w, t.root, 0)
+}
+
+// dump writes branch n indented by its depth, right branch first
+func dump[T any](w io.Writer, n *TreeNode[T], depth int) error {
+	if n == nil {
+		return nil
+	}
+	if err := dump(w, n.right, depth+1); err != nil {
+		return err
+	}
+	copies := ""
+	if n.count > 1 {
+		copies = fmt.Sprintf(" x%d", n.count)
+	}
+	if _, err := fmt.Fprintf(w, "%s%v%s\n", strings.Repeat("    ", depth), n.value, copies); err != nil {
+		return err
+	}
+	return dump(w, n.left, depth+1)
+}
+
+// String returns tree as written by Dump()
+func (t Tree[T]) String() string {
+	var b strings.Builder
+	t.Dump(&b)
+	return b.String()
+}
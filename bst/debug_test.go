@@ -0,0 +1,82 @@
+// debug_test.go
+
+package bst
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree := NewOrdered[int]()
+	for i := 0; i < 200; i++ {
+		if n := r.Intn(50); i%3 == 2 {
+			tree.Remove(n)
+		} else {
+			tree.Insert(n)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+	}
+
+	tree = NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7, 1} {
+		tree.Insert(n)
+	}
+	n := tree.Search(7, nil)
+	n.value = 99
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "99") {
+		t.Errorf("Order violation not reported, found: %v", err)
+	}
+	n.value = 7
+
+	n.parent = tree.GetRoot()
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "parent") {
+		t.Errorf("Parent violation not reported, found: %v", err)
+	}
+	n.parent = tree.Search(9, nil)
+
+	n.count = 2
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "copies") {
+		t.Errorf("Count violation not reported, found: %v", err)
+	}
+	tree.SetDuplicates(CountDuplicates)
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Valid multiset failed: %v", err)
+	}
+
+	n.count = 1
+	tree.SetDuplicates(AllowDuplicates)
+	tree.Insert(5)
+	tree.SetDuplicates(RejectDuplicates)
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "duplicates") {
+		t.Errorf("Duplicate violation not reported, found: %v", err)
+	}
+}
+
+func TestDump(t *testing.T) {
+	tree := NewOrdered[int]()
+	if tree.String() != "" {
+		t.Errorf("Empty tree dumped: %q", tree.String())
+	}
+	for _, n := range []int{5, 2, 9, 7, 1, 7} {
+		tree.Insert(n)
+	}
+	want := "    9\n            7\n        7\n5\n    2\n        1\n"
+	if tree.String() != want {
+		t.Errorf("Invalid dump, found:\n%s\nexpected:\n%s", tree.String(), want)
+	}
+
+	tree = NewOrdered[int]()
+	tree.SetDuplicates(CountDuplicates)
+	for _, n := range []int{5, 2, 5} {
+		tree.Insert(n)
+	}
+	var b strings.Builder
+	if err := tree.Dump(&b); err != nil || b.String() != "5 x2\n    2\n" {
+		t.Errorf("Invalid dump, found:\n%s", b.String())
+	}
+}
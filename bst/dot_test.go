@@ -0,0 +1,48 @@
+// dot_test.go
+
+package bst
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWriteDOT(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7} {
+		tree.Insert(n)
+	}
+	want := `digraph bst {
+	node [shape=circle];
+	n0 [label="5"];
+	n1 [label="2"];
+	n0 -> n1;
+	n2 [label="9"];
+	n3 [label="7"];
+	n2 -> n3;
+	n4 [shape=point];
+	n2 -> n4;
+	n0 -> n2;
+}
+`
+	var b strings.Builder
+	if err := tree.WriteDOT(&b, nil); err != nil || b.String() != want {
+		t.Errorf("Invalid DOT, found:\n%s\nexpected:\n%s", b.String(), want)
+	}
+
+	b.Reset()
+	tree = NewOrdered[int]()
+	tree.Insert(1)
+	if err := tree.WriteDOT(&b, func(n int) string {
+		return `"` + strconv.Itoa(n) + `"`
+	}); err != nil || !strings.Contains(b.String(), `n0 [label="\"1\""];`) {
+		t.Errorf("Invalid escaped DOT, found:\n%s", b.String())
+	}
+
+	b.Reset()
+	var empty BinaryTree
+	if err := empty.WriteDOT(&b, nil); err != nil || b.String() != "digraph bst {\n\tnode [shape=circle];\n}\n" {
+		t.Errorf("Invalid empty DOT, found:\n%s", b.String())
+	}
+}
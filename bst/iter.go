@@ -0,0 +1,135 @@
+// iter.go
+
+package bst
+
+import "iter"
+
+// All iterates over items in order
+func (t Tree[T]) All() iter.Seq[T] {
+	return t.ascendFrom(t.GetMinimum(t.root))
+}
+
+// Backward iterates over items in reverse order
+func (t Tree[T]) Backward() iter.Seq[T] {
+	return t.descendFrom(t.GetMaximum(t.root))
+}
+
+// Ascend iterates in order over items greater than or equal to from
+func (t Tree[T]) Ascend(from T) iter.Seq[T] {
+	return t.ascendFrom(t.LowerBound(from))
+}
+
+// Descend iterates in reverse order over items less than or equal to from
+func (t Tree[T]) Descend(from T) iter.Seq[T] {
+	return t.descendFrom(t.Floor(from))
+}
+
+// ascendFrom iterates in order starting at node n
+func (t Tree[T]) ascendFrom(n *TreeNode[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := n; x != nil; x = t.GetNext(x) {
+			if !yieldCopies(yield, x) {
+				return
+			}
+		}
+	}
+}
+
+// descendFrom iterates in reverse order starting at node n
+func (t Tree[T]) descendFrom(n *TreeNode[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := n; x != nil; x = t.GetPrevious(x) {
+			if !yieldCopies(yield, x) {
+				return
+			}
+		}
+	}
+}
+
+// PreOrder iterates current, left, right
+func (t Tree[T]) PreOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*TreeNode[T]
+		if t.root != nil {
+			stack = append(stack, t.root)
+		}
+		for len(stack) > 0 {
+			x := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yieldCopies(yield, x) {
+				return
+			}
+			if x.right != nil {
+				stack = append(stack, x.right)
+			}
+			if x.left != nil {
+				stack = append(stack, x.left)
+			}
+		}
+	}
+}
+
+// firstPostOrder finds first node of branch to visit in post order, the leftmost leaf
+func firstPostOrder[T any](n *TreeNode[T]) *TreeNode[T] {
+	x := n
+	for x != nil {
+		if x.left != nil {
+			x = x.left
+		} else if x.right != nil {
+			x = x.right
+		} else {
+			break
+		}
+	}
+	return x
+}
+
+// PostOrder iterates left, right, current
+func (t Tree[T]) PostOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := firstPostOrder(t.root); x != nil; {
+			if !yieldCopies(yield, x) {
+				return
+			}
+			p := x.parent
+			if p != nil && x == p.left && p.right != nil {
+				x = firstPostOrder(p.right) // right sibling branch is next
+			} else {
+				x = p
+			}
+		}
+	}
+}
+
+// LevelOrder iterates breadth first, top to bottom, left to right
+func (t Tree[T]) LevelOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var queue []*TreeNode[T]
+		if t.root != nil {
+			queue = append(queue, t.root)
+		}
+		for len(queue) > 0 {
+			x := queue[0]
+			queue = queue[1:]
+			if !yieldCopies(yield, x) {
+				return
+			}
+			if x.left != nil {
+				queue = append(queue, x.left)
+			}
+			if x.right != nil {
+				queue = append(queue, x.right)
+			}
+		}
+	}
+}
+
+// yieldCopies yields item of node once per copy, false if loop stopped
+func yieldCopies[T any](yield func(T) bool, n *TreeNode[T]) bool {
+	for i := 0; i < n.count; i++ {
+		if !yield(n.value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+// iter_test.go
+
+package bst
+
+import (
+	"iter"
+	"testing"
+)
+
+func TestIterators(t *testing.T) {
+	// perfectly balanced insertion order, same shape for any tree
+	var tree BinaryTree
+	for x, n := range []Item{4, 2, 6, 1, 3, 5, 7} {
+		if x == 0 {
+			tree.Init(n, nil, nil)
+		} else {
+			tree.Insert(n)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		givenSeq iter.Seq[Item]
+
+		wantArray []Item
+	}{
+		{"All", tree.All(), []Item{1, 2, 3, 4, 5, 6, 7}},
+		{"Backward", tree.Backward(), []Item{7, 6, 5, 4, 3, 2, 1}},
+		{"Ascend", tree.Ascend(3), []Item{3, 4, 5, 6, 7}},
+		{"Ascend missing", tree.Ascend(0), []Item{1, 2, 3, 4, 5, 6, 7}},
+		{"Descend", tree.Descend(3), []Item{3, 2, 1}},
+		{"Descend missing", tree.Descend(9), []Item{7, 6, 5, 4, 3, 2, 1}},
+		{"PreOrder", tree.PreOrder(), []Item{4, 2, 1, 3, 6, 5, 7}},
+		{"PostOrder", tree.PostOrder(), []Item{1, 3, 2, 5, 7, 6, 4}},
+		{"LevelOrder", tree.LevelOrder(), []Item{4, 2, 6, 1, 3, 5, 7}},
+	}
+
+	for _, test := range tests {
+		x := 0
+		for y := range test.givenSeq {
+			if x >= len(test.wantArray) || y != test.wantArray[x] {
+				t.Errorf("%s: Invalid order at %d, found: %v", test.name, x, y)
+				break
+			}
+			x++
+		}
+		if x != len(test.wantArray) {
+			t.Errorf("%s: Iterated %d items, expected: %d", test.name, x, len(test.wantArray))
+		}
+
+		// stopping early
+		x = 0
+		for range test.givenSeq {
+			x++
+			if x == 2 {
+				break
+			}
+		}
+		if x != 2 {
+			t.Errorf("%s: Did not stop early", test.name)
+		}
+	}
+
+	var empty BinaryTree
+	for y := range empty.All() {
+		t.Errorf("Empty tree iterated %v", y)
+	}
+	for y := range empty.LevelOrder() {
+		t.Errorf("Empty tree iterated %v", y)
+	}
+
+	// recursive walk closes channel on nil root
+	ch := make(chan Item)
+	go empty.InOrderTreeWalkRecursive(empty.GetRoot(), ch)
+	for y := range ch {
+		t.Errorf("Empty tree walked %v", y)
+	}
+}
@@ -0,0 +1,80 @@
+// json_test.go
+
+package bst
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7, 2} {
+		tree.Insert(n)
+	}
+	got, err := json.Marshal(tree)
+	if want := "[2,2,5,7,9]"; err != nil || string(got) != want {
+		t.Errorf("Invalid JSON, found: %s, expected: %s", got, want)
+	}
+	got, err = json.Marshal(struct{ Tree *Tree[string] }{NewOrdered[string]()})
+	if want := `{"Tree":[]}`; err != nil || string(got) != want {
+		t.Errorf("Invalid empty JSON, found: %s, expected: %s", got, want)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		givenJSON   string
+		givenPolicy Duplicates
+
+		wantArray []int
+	}{
+		{"[5,1,3,1]", AllowDuplicates, []int{1, 1, 3, 5}},
+		{"[5,1,3,1]", KeepFirstDuplicate, []int{1, 3, 5}},
+		{"[]", AllowDuplicates, []int{}},
+		{"null", AllowDuplicates, []int{}},
+	}
+	for _, test := range tests {
+		tree := NewOrdered[int]()
+		tree.SetDuplicates(test.givenPolicy)
+		tree.Insert(42) // replaced
+		if err := json.Unmarshal([]byte(test.givenJSON), tree); err != nil {
+			t.Fatalf("%s: %v", test.givenJSON, err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Errorf("%s: %v", test.givenJSON, err)
+		}
+		if got := slices.Collect(tree.All()); !slices.Equal(got, test.wantArray) {
+			t.Errorf("%s: Invalid order, found: %v, expected: %v", test.givenJSON, got, test.wantArray)
+		}
+	}
+
+	tree := NewOrdered[int]()
+	tree.Insert(42)
+	if err := json.Unmarshal([]byte(`[1,"a"]`), tree); err == nil || tree.Len() != 1 {
+		t.Errorf("Invalid JSON not reported, found: %v, %d items", err, tree.Len())
+	}
+
+	// no comparison to rebuild with, including a nil field json allocates
+	var zero BinaryTree
+	if err := json.Unmarshal([]byte("[3,1,2]"), &zero); err != ErrNoComparison {
+		t.Errorf("Missing comparison not reported, found: %v", err)
+	}
+	var field struct{ Tree *BinaryTree }
+	if err := json.Unmarshal([]byte(`{"Tree":[3,1,2]}`), &field); err != ErrNoComparison {
+		t.Errorf("Missing comparison not reported for field, found: %v", err)
+	}
+
+	// Items decode numbers as float64
+	items := NewCompare(func(a, b Item) int {
+		return cmp.Compare(a.(float64), b.(float64))
+	})
+	if err := json.Unmarshal([]byte("[3,1.5,2]"), items); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := slices.Collect(items.All()), []Item{1.5, 2.0, 3.0}; !slices.Equal(got, want) {
+		t.Errorf("Invalid order, found: %v, expected: %v", got, want)
+	}
+}
@@ -0,0 +1,99 @@
+// range.go
+
+package bst
+
+// Bound - which ends of a range are included
+type Bound uint8
+
+// Range bounds, bounds need not be in the tree
+const (
+	ExcludeBoth Bound = 0                        // lo < x < hi
+	IncludeLow  Bound = 1 << 0                   // lo <= x < hi
+	IncludeHigh Bound = 1 << 1                   // lo < x <= hi
+	IncludeBoth Bound = IncludeLow | IncludeHigh // lo <= x <= hi
+)
+
+// LowerBound finds first node with item not less than k, or nil if none
+func (t Tree[T]) LowerBound(k T) *TreeNode[T] {
+	var found *TreeNode[T]
+	for x := t.root; x != nil; {
+		if t.compare(x.value, k) >= 0 {
+			found = x
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	return found
+}
+
+// UpperBound finds first node with item greater than k, or nil if none
+func (t Tree[T]) UpperBound(k T) *TreeNode[T] {
+	var found *TreeNode[T]
+	for x := t.root; x != nil; {
+		if t.compare(x.value, k) > 0 {
+			found = x
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	return found
+}
+
+// Ceiling finds node with least item greater than or equal to k, or nil if none
+//  alias for LowerBound()
+func (t Tree[T]) Ceiling(k T) *TreeNode[T] {
+	return t.LowerBound(k)
+}
+
+// Floor finds node with greatest item less than or equal to k, or nil if none
+func (t Tree[T]) Floor(k T) *TreeNode[T] {
+	var found *TreeNode[T]
+	for x := t.root; x != nil; {
+		if t.compare(x.value, k) <= 0 {
+			found = x
+			x = x.right
+		} else {
+			x = x.left
+		}
+	}
+	return found
+}
+
+// rangeStart finds first node in range, or nil if none
+func (t Tree[T]) rangeStart(lo T, b Bound) *TreeNode[T] {
+	if b&IncludeLow != 0 {
+		return t.LowerBound(lo)
+	}
+	return t.UpperBound(lo)
+}
+
+// inRange checks node against the high end of range
+func (t Tree[T]) inRange(x *TreeNode[T], hi T, b Bound) bool {
+	if x == nil {
+		return false
+	}
+	c := t.compare(x.value, hi)
+	return c < 0 || (c == 0 && b&IncludeHigh != 0)
+}
+
+// Range returns items between lo and hi in order
+func (t Tree[T]) Range(lo, hi T, b Bound) []T {
+	var items []T
+	for x := t.rangeStart(lo, b); t.inRange(x, hi, b); x = t.GetNext(x) {
+		for i := 0; i < x.count; i++ {
+			items = append(items, x.value)
+		}
+	}
+	return items
+}
+
+// CountRange returns number of items between lo and hi
+func (t Tree[T]) CountRange(lo, hi T, b Bound) int {
+	count := 0
+	for x := t.rangeStart(lo, b); t.inRange(x, hi, b); x = t.GetNext(x) {
+		count += x.count
+	}
+	return count
+}
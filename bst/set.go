@@ -0,0 +1,68 @@
+// set.go
+
+package bst
+
+import "github.com/PuppyKhan/jebe/collection"
+
+// Tree of Items is a collection.SortedSet
+var _ collection.SortedSet = (*BinaryTree)(nil)
+
+// Remove deletes an item equal to k, one copy with CountDuplicates, returns false if none
+func (t *Tree[T]) Remove(k T) bool {
+	n := t.Search(k, nil)
+	if n == nil {
+		return false
+	}
+	t.deleteOne(n)
+	return true
+}
+
+// Contains reports whether an item equal to k is in tree
+func (t Tree[T]) Contains(k T) bool {
+	return t.Search(k, nil) != nil
+}
+
+// Min returns lowest item, false if tree is empty
+func (t Tree[T]) Min() (T, bool) {
+	return valueOf(t.GetMinimum(t.root))
+}
+
+// Max returns highest item, false if tree is empty
+func (t Tree[T]) Max() (T, bool) {
+	return valueOf(t.GetMaximum(t.root))
+}
+
+// Len returns number of items in tree, counted by walking it
+func (t Tree[T]) Len() int {
+	n := 0
+	for range t.All() {
+		n++
+	}
+	return n
+}
+
+// Count returns number of items equal to k
+func (t Tree[T]) Count(k T) int {
+	return t.CountRange(k, k, IncludeBoth)
+}
+
+// TryPeek returns lowest item without removing it, false if tree is empty
+func (t Tree[T]) TryPeek() (T, bool) {
+	return t.Min()
+}
+
+// TryPop returns lowest item and removes it from tree, false if tree is empty
+func (t *Tree[T]) TryPop() (T, bool) {
+	n := t.GetMinimum(t.root)
+	t.deleteOne(n)
+	return valueOf(n)
+}
+
+// valueOf returns item of node, false if node is nil
+func valueOf[T any](n *TreeNode[T]) (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.value, true
+}
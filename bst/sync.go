@@ -0,0 +1,79 @@
+// sync.go
+
+package bst
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncTree - tree safe for concurrent use
+//  readers share a lock for Search/Range/walks, writers take it alone for Insert/Remove
+//  items are passed by value since nodes can't be held on to outside the lock
+type SyncTree[T any] struct {
+	mu   sync.RWMutex
+	tree *Tree[T]
+}
+
+// NewSyncTree wraps t for concurrent use
+//  t must not be used directly afterwards
+func NewSyncTree[T any](t *Tree[T]) *SyncTree[T] {
+	return &SyncTree[T]{tree: t}
+}
+
+// Insert a new Item to the tree
+func (s *SyncTree[T]) Insert(newValue T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tree.Insert(newValue)
+}
+
+// Remove deletes an item equal to k, returns false if none
+func (s *SyncTree[T]) Remove(k T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tree.Remove(k)
+}
+
+// Search returns the stored item equal to k, false if none
+func (s *SyncTree[T]) Search(k T) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return valueOf(s.tree.Search(k, nil))
+}
+
+// Range returns items between lo and hi in order
+func (s *SyncTree[T]) Range(lo, hi T, b Bound) []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tree.Range(lo, hi, b)
+}
+
+// All iterates over items in order, holding the read lock until done
+//  the loop body must not call writers on s
+func (s *SyncTree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		for x := range s.tree.All() {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// View calls f with the tree under a read lock
+//  f must not change the tree
+func (s *SyncTree[T]) View(f func(t *Tree[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f(s.tree)
+}
+
+// Update calls f with the tree under a write lock
+func (s *SyncTree[T]) Update(f func(t *Tree[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s.tree)
+}
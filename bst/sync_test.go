@@ -0,0 +1,65 @@
+// sync_test.go
+
+package bst
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncTree(t *testing.T) {
+	s := NewSyncTree(NewOrdered[int]())
+
+	const writers, each = 4, 200
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for n := range each {
+				s.Insert(w*each + n)
+			}
+			for n := 0; n < each; n += 2 {
+				if !s.Remove(w*each + n) {
+					t.Errorf("Remove of %d failed", w*each+n)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for n := range each {
+				s.Search(w*each + n)
+				s.Range(w*each, w*each+10, IncludeBoth)
+				for range s.All() {
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	want := 1
+	for x := range s.All() {
+		if x != want {
+			t.Fatalf("Invalid order, found: %d, expected: %d", x, want)
+		}
+		want += 2
+	}
+	if want != writers*each+1 {
+		t.Errorf("Walk stopped before %d", want)
+	}
+	if _, ok := s.Search(2); ok || s.Remove(2) {
+		t.Errorf("Found removed item 2")
+	}
+	if x, ok := s.Search(3); !ok || x != 3 {
+		t.Errorf("Search of 3 failed")
+	}
+	s.Update(func(tree *Tree[int]) {
+		tree.Insert(2)
+	})
+	s.View(func(tree *Tree[int]) {
+		if tree.Search(2, nil) == nil {
+			t.Errorf("Update lost item 2")
+		}
+	})
+}
@@ -0,0 +1,31 @@
+// codec.go
+
+package collection
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// Codec - converts items of type T to and from bytes, for MarshalBinary and UnmarshalBinary
+type Codec[T any] struct {
+	Encode func(item T) ([]byte, error)
+	Decode func(data []byte) (T, error)
+}
+
+// GobCodec returns a Codec encoding each item with encoding/gob, the default when none is set
+//  Items held as interface{} must be of gob.Register'ed types, basic types already are
+func GobCodec[T any]() Codec[T] {
+	return Codec[T]{
+		Encode: func(item T) ([]byte, error) {
+			var b bytes.Buffer
+			err := gob.NewEncoder(&b).Encode(&item) // pointer, so interface{} items keep their type
+			return b.Bytes(), err
+		},
+		Decode: func(data []byte) (T, error) {
+			var item T
+			err := gob.NewDecoder(bytes.NewReader(data)).Decode(&item)
+			return item, err
+		},
+	}
+}
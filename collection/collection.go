@@ -0,0 +1,41 @@
+// collection.go
+
+package collection
+
+import "iter"
+
+// Item - the type to be sorted
+type Item interface{}
+
+// Prioritize - custom comparison for prioritizing items of type T
+//  basic sort would need "a < b" ("a > b" for high to low)
+type Prioritize[T any] func(a, b T) bool
+
+// Equivalence - custom comparison for equality of items of type T, "a == b"
+type Equivalence[T any] func(a, b T) bool
+
+// Comparison - custom three-way comparison of items of type T
+//  negative for "a < b", 0 for "a == b", positive for "a > b", like cmp.Compare
+type Comparison[T any] func(a, b T) int
+
+// OrderedCollection - items of type T kept in order of the collection's comparison
+//  implemented by bst.Tree, avl.Tree and rbtree.Tree
+type OrderedCollection[T any] interface {
+	// Insert a new item
+	Insert(item T)
+	// Remove deletes an item equal to item, returns false if none
+	Remove(item T) bool
+	// Contains reports whether an item equal to item is present
+	Contains(item T) bool
+	// Min returns lowest item, false if collection is empty
+	Min() (T, bool)
+	// Max returns highest item, false if collection is empty
+	Max() (T, bool)
+	// Len returns number of items
+	Len() int
+	// All iterates over items in order
+	All() iter.Seq[T]
+}
+
+// SortedSet - OrderedCollection of Items, the interface{} API over the generic one
+type SortedSet = OrderedCollection[Item]
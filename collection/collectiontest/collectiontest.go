@@ -0,0 +1,123 @@
+// collectiontest.go
+
+// Package collectiontest is a conformance suite for collection.OrderedCollection implementations
+package collectiontest
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/PuppyKhan/jebe/collection"
+)
+
+// Run tests an implementation, newCollection must return an empty collection of ints ordered low to high
+func Run(t *testing.T, newCollection func() collection.OrderedCollection[int]) {
+	t.Run("Empty", func(t *testing.T) {
+		testEmpty(t, newCollection())
+	})
+	t.Run("InsertRemove", func(t *testing.T) {
+		testInsertRemove(t, newCollection())
+	})
+	t.Run("Random", func(t *testing.T) {
+		testRandom(t, newCollection())
+	})
+	t.Run("AllBreak", func(t *testing.T) {
+		testAllBreak(t, newCollection())
+	})
+}
+
+// check compares collection contents against want, which must be sorted
+func check(t *testing.T, c collection.OrderedCollection[int], want []int) {
+	t.Helper()
+	if c.Len() != len(want) {
+		t.Fatalf("Invalid length, found: %d, expected: %d", c.Len(), len(want))
+	}
+	if got := slices.Collect(c.All()); !slices.Equal(got, want) {
+		t.Fatalf("Invalid order, found: %v, expected: %v", got, want)
+	}
+	for _, n := range want {
+		if !c.Contains(n) {
+			t.Fatalf("Missing item %d", n)
+		}
+	}
+	if len(want) == 0 {
+		return
+	}
+	if m, ok := c.Min(); !ok || m != want[0] {
+		t.Fatalf("Invalid minimum, found: %d, expected: %d", m, want[0])
+	}
+	if m, ok := c.Max(); !ok || m != want[len(want)-1] {
+		t.Fatalf("Invalid maximum, found: %d, expected: %d", m, want[len(want)-1])
+	}
+}
+
+func testEmpty(t *testing.T, c collection.OrderedCollection[int]) {
+	check(t, c, []int{})
+	if _, ok := c.Min(); ok {
+		t.Errorf("Empty collection has a minimum")
+	}
+	if _, ok := c.Max(); ok {
+		t.Errorf("Empty collection has a maximum")
+	}
+	if c.Contains(0) || c.Remove(0) {
+		t.Errorf("Empty collection has items")
+	}
+}
+
+func testInsertRemove(t *testing.T, c collection.OrderedCollection[int]) {
+	for _, n := range []int{5, 2, 9, 7, 1, 3, 4, 24, 14, 34, -1, 12, 18, 10, 16} {
+		c.Insert(n)
+	}
+	check(t, c, []int{-1, 1, 2, 3, 4, 5, 7, 9, 10, 12, 14, 16, 18, 24, 34})
+
+	for _, n := range []int{24, -1, 5, 34} {
+		if !c.Remove(n) {
+			t.Fatalf("Remove of %d failed", n)
+		}
+	}
+	if c.Remove(24) || c.Remove(99) || c.Contains(24) || c.Contains(99) {
+		t.Errorf("Found missing item")
+	}
+	check(t, c, []int{1, 2, 3, 4, 7, 9, 10, 12, 14, 16, 18})
+}
+
+func testRandom(t *testing.T, c collection.OrderedCollection[int]) {
+	r := rand.New(rand.NewSource(1))
+	present := map[int]bool{}
+	for i := 0; i < 500; i++ {
+		n := r.Intn(200)
+		if present[n] {
+			delete(present, n)
+			if !c.Remove(n) {
+				t.Fatalf("Remove of %d failed", n)
+			}
+		} else {
+			present[n] = true
+			c.Insert(n)
+		}
+	}
+
+	want := make([]int, 0, len(present))
+	for n := range present {
+		want = append(want, n)
+	}
+	slices.Sort(want)
+	check(t, c, want)
+}
+
+func testAllBreak(t *testing.T, c collection.OrderedCollection[int]) {
+	for n := range 10 {
+		c.Insert(n)
+	}
+	x := 0
+	for range c.All() {
+		x++
+		if x == 3 {
+			break
+		}
+	}
+	if x != 3 {
+		t.Errorf("Walked %d items, expected: 3", x)
+	}
+}
@@ -0,0 +1,68 @@
+// binary.go
+
+package heap
+
+import (
+	"errors"
+
+	"github.com/PuppyKhan/jebe/collection"
+	"github.com/PuppyKhan/jebe/internal/wire"
+)
+
+// ErrNoComparison - returned by UnmarshalBinary() and UnmarshalJSON() into a heap without a comparison set
+var ErrNoComparison = errors.New("heap: no comparison set")
+
+// ErrNotHeap - returned by UnmarshalBinary for items not in heap order
+var ErrNotHeap = errors.New("heap: items not in heap order")
+
+// SetCodec sets how items are encoded by MarshalBinary and decoded by UnmarshalBinary
+//  defaults to collection.GobCodec, needing gob.Register for custom types held in Items
+func (h *Heap[T]) SetCodec(c collection.Codec[T]) {
+	h.codec = c
+}
+
+// MarshalBinary encodes the Size() items of heap in array order, also used by encoding/gob
+//  the comparison and codec are not kept
+func (h Heap[T]) MarshalBinary() ([]byte, error) {
+	e := wire.NewEncoder(wire.Heap, h.codec)
+	e.Uint(uint64(h.Size()))
+	for i := uint(0); i < h.Size(); i++ {
+		if err := e.Item(h.array[i]); err != nil {
+			return nil, err
+		}
+	}
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinary replaces contents of heap with one encoded by MarshalBinary
+//  comparison, and codec if not the default, must be set first, else ErrNoComparison is returned
+//  items are checked to be in heap order, on any error heap is left unchanged
+func (h *Heap[T]) UnmarshalBinary(data []byte) error {
+	if h.greater == nil {
+		return ErrNoComparison
+	}
+	d, err := wire.NewDecoder(data, wire.Heap, h.codec)
+	if err != nil {
+		return err
+	}
+	n, err := d.Len(1) // item length
+	if err != nil {
+		return err
+	}
+	array := make([]T, n)
+	for i := range array {
+		if array[i], err = d.Item(); err != nil {
+			return err
+		}
+	}
+	if err := d.Done(); err != nil {
+		return err
+	}
+	for i := uint(1); i < uint(n); i++ {
+		if p, _ := Parent(i); h.greater(array[i], array[p]) {
+			return ErrNotHeap
+		}
+	}
+	h.SetArray(array)
+	return nil
+}
@@ -0,0 +1,79 @@
+// binary_test.go
+
+package heap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	h := NewMax[int]()
+	for _, n := range []int{5, 2, 9, 7, 1, 8} {
+		h.Insert(n)
+	}
+	h.Pop() // left out, past Size()
+	data, err := h.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := NewMax[int]()
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := loaded.array, h.array[:h.Size()]; !slices.Equal(got, want) {
+		t.Errorf("Invalid array, found: %v, expected: %v", got, want)
+	}
+
+	// heap order is checked with the receiver's comparison
+	minHeap := NewMin[int]()
+	minHeap.Insert(3)
+	if err := minHeap.UnmarshalBinary(data); err != ErrNotHeap {
+		t.Errorf("Heap order violation not reported, found: %v", err)
+	}
+	if minHeap.Size() != 1 {
+		t.Errorf("Heap changed by invalid data")
+	}
+	if err := minHeap.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Truncated data not reported")
+	}
+}
+
+func TestGob(t *testing.T) {
+	h := New[Item](nil)
+	for _, n := range []int{5, 2, 9, 7} {
+		h.Insert(n)
+	}
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(h); err != nil {
+		t.Fatal(err)
+	}
+	loaded := New[Item](nil)
+	if err := gob.NewDecoder(&b).Decode(loaded); err != nil {
+		t.Fatal(err)
+	}
+	var got []Item
+	for loaded.Size() > 0 {
+		got = append(got, loaded.Pop())
+	}
+	if want := []Item{9, 7, 5, 2}; !slices.Equal(got, want) {
+		t.Errorf("Invalid order, found: %v, expected: %v", got, want)
+	}
+
+	// a zero value has no comparison to check items against
+	words := New(func(a, b Item) bool {
+		return a.(string) > b.(string)
+	})
+	words.Insert("kyle")
+	words.Insert("eric")
+	b.Reset()
+	if err := gob.NewEncoder(&b).Encode(words); err != nil {
+		t.Fatal(err)
+	}
+	var zero BinaryHeap
+	if err := gob.NewDecoder(&b).Decode(&zero); err != ErrNoComparison || zero.Size() != 0 {
+		t.Errorf("Missing comparison not reported, found: %v", err)
+	}
+}
@@ -0,0 +1,228 @@
+// binomial.go
+
+package heap
+
+// BinomialItem - item in a BinomialHeap, the handle returned by Insert()
+//  items move between tree nodes as priorities change, so handles stay valid
+type BinomialItem[T any] struct {
+	value T
+	node  *binomialNode[T] // nil once extracted
+}
+
+// Value - the item held
+func (i *BinomialItem[T]) Value() T {
+	return i.value
+}
+
+// binomialNode - node of a binomial tree, holding an item
+type binomialNode[T any] struct {
+	item    *BinomialItem[T]
+	parent  *binomialNode[T]
+	child   *binomialNode[T] // child of highest degree
+	sibling *binomialNode[T] // next root, or next child of lower degree
+	degree  int
+}
+
+// BinomialHeap - pointer based heap, a list of binomial trees of distinct degrees
+//  Insert, ExtractMax, DecreaseKey and Meld in O(log n), PeekMax in O(1)
+type BinomialHeap[T any] struct {
+	head    *binomialNode[T] // root list, increasing degree
+	top     *binomialNode[T] // root with highest priority
+	greater Prioritize[T]
+	size    int
+}
+
+// NewBinomial returns an empty binomial heap prioritized by gt
+//  if gt is nil, default casts items as type int
+func NewBinomial[T any](gt Prioritize[T]) *BinomialHeap[T] {
+	h := &BinomialHeap[T]{}
+	if gt == nil {
+		h.SetGTIntPrioritizeHeapItem()
+	} else {
+		h.SetPrioritizeHeapItem(gt)
+	}
+	return h
+}
+
+// SetPrioritizeHeapItem - "a > b" or whatever comparison is needed
+func (h *BinomialHeap[T]) SetPrioritizeHeapItem(a Prioritize[T]) {
+	h.greater = a
+}
+
+// SetGTIntPrioritizeHeapItem - default "a > b" as ints
+func (h *BinomialHeap[T]) SetGTIntPrioritizeHeapItem() {
+	h.greater = func(a, b T) bool {
+		return any(a).(int) > any(b).(int)
+	}
+}
+
+// Len returns number of items in heap
+func (h BinomialHeap[T]) Len() int {
+	return h.size
+}
+
+// link makes root y the first child of root z, both of same degree
+func (h *BinomialHeap[T]) link(y, z *binomialNode[T]) {
+	y.parent = z
+	y.sibling = z.child
+	z.child = y
+	z.degree++
+}
+
+// mergeRoots merges two root lists in order of degree
+func mergeRoots[T any](a, b *binomialNode[T]) *binomialNode[T] {
+	var head binomialNode[T]
+	tail := &head
+	for a != nil && b != nil {
+		if a.degree <= b.degree {
+			tail.sibling, a = a, a.sibling
+		} else {
+			tail.sibling, b = b, b.sibling
+		}
+		tail = tail.sibling
+	}
+	if a != nil {
+		tail.sibling = a
+	} else {
+		tail.sibling = b
+	}
+	return head.sibling
+}
+
+// union merges root list b into h, linking trees until degrees are distinct
+func (h *BinomialHeap[T]) union(b *binomialNode[T]) {
+	h.head = mergeRoots(h.head, b)
+	var prev *binomialNode[T]
+	x := h.head
+	for x != nil && x.sibling != nil {
+		next := x.sibling
+		if x.degree != next.degree || (next.sibling != nil && next.sibling.degree == x.degree) {
+			prev, x = x, next
+		} else if !h.greater(next.item.value, x.item.value) {
+			x.sibling = next.sibling
+			h.link(next, x)
+		} else {
+			if prev == nil {
+				h.head = next
+			} else {
+				prev.sibling = next
+			}
+			h.link(x, next)
+			x = next
+		}
+	}
+	h.findTop()
+}
+
+// findTop resets top to the root with highest priority
+func (h *BinomialHeap[T]) findTop() {
+	h.top = h.head
+	for x := h.head; x != nil; x = x.sibling {
+		if h.greater(x.item.value, h.top.item.value) {
+			h.top = x
+		}
+	}
+}
+
+// Insert - add new item to heap, returns its handle
+func (h *BinomialHeap[T]) Insert(key T) *BinomialItem[T] {
+	item := &BinomialItem[T]{value: key}
+	item.node = &binomialNode[T]{item: item}
+	h.union(item.node)
+	h.size++
+	return item
+}
+
+// Push - alias for Insert()
+func (h *BinomialHeap[T]) Push(key T) *BinomialItem[T] {
+	return h.Insert(key)
+}
+
+// PeekMax - returns prioritized item without removing it, zero value if heap is empty
+func (h BinomialHeap[T]) PeekMax() T {
+	if h.top == nil {
+		var zero T
+		return zero
+	}
+	return h.top.item.value
+}
+
+// Peek - alias for PeekMax()
+func (h BinomialHeap[T]) Peek() T {
+	return h.PeekMax()
+}
+
+// ExtractMax - returns prioritized item and removes it from heap, zero value if heap is empty
+func (h *BinomialHeap[T]) ExtractMax() T {
+	t := h.top
+	if t == nil {
+		var zero T
+		return zero
+	}
+
+	// take top out of root list
+	if h.head == t {
+		h.head = t.sibling
+	} else {
+		prev := h.head
+		for prev.sibling != t {
+			prev = prev.sibling
+		}
+		prev.sibling = t.sibling
+	}
+
+	// its children, reversed into increasing degree, become roots
+	var children *binomialNode[T]
+	for c := t.child; c != nil; {
+		next := c.sibling
+		c.parent = nil
+		c.sibling = children
+		children = c
+		c = next
+	}
+	h.union(children)
+	h.size--
+
+	t.item.node = nil
+	return t.item.value
+}
+
+// Pop - alias for ExtractMax()
+func (h *BinomialHeap[T]) Pop() T {
+	return h.ExtractMax()
+}
+
+// DecreaseKey - moves item to a key of higher priority, a decrease for min heaps
+//  returns false, leaving item unchanged, if key has lower priority or item is not in heap
+func (h *BinomialHeap[T]) DecreaseKey(item *BinomialItem[T], key T) bool {
+	if item == nil || item.node == nil || h.greater(item.value, key) {
+		return false
+	}
+	item.value = key
+	n := item.node
+	for n.parent != nil && h.greater(n.item.value, n.parent.item.value) {
+		// swap items with parent, keeping handles pointing at their nodes
+		p := n.parent
+		n.item, p.item = p.item, n.item
+		n.item.node = n
+		p.item.node = p
+		n = p
+	}
+	if n.parent == nil && h.greater(n.item.value, h.top.item.value) {
+		h.top = n
+	}
+	return true
+}
+
+// Meld moves all items of other into h in O(log n), leaving other empty
+//  handles from other stay valid in h
+func (h *BinomialHeap[T]) Meld(other *BinomialHeap[T]) {
+	if other == h {
+		return
+	}
+	h.union(other.head)
+	h.size += other.size
+	other.head = nil
+	other.top = nil
+	other.size = 0
+}
@@ -0,0 +1,207 @@
+// dary.go
+
+package heap
+
+import "errors"
+
+// DaryHeap - heap where each node has d children instead of 2
+//  wider, shallower trees trade more comparisons per level for fewer levels and better locality
+type DaryHeap[T any] struct {
+	array       []T
+	greater     Prioritize[T]
+	logicalSize uint
+	d           uint
+}
+
+// DaryParent node number of node i in a d-ary heap
+func DaryParent(i, d uint) (uint, error) {
+	if i == 0 {
+		return 0, errors.New("no parent")
+	}
+	return (i - 1) / d, nil // integer division truncates, thus floor result
+}
+
+// DaryChild node number of k-th child of node i in a d-ary heap, counting from 0
+func DaryChild(i, d, k uint) uint {
+	return (d * i) + k + 1
+}
+
+// NewDary returns an empty d-ary heap prioritized by gt
+//  d below 2 is treated as 2, if gt is nil, default casts items as type int
+func NewDary[T any](d uint, gt Prioritize[T]) *DaryHeap[T] {
+	h := &DaryHeap[T]{}
+	h.SetArity(d)
+	if gt == nil {
+		h.SetGTIntPrioritizeHeapItem()
+	} else {
+		h.SetPrioritizeHeapItem(gt)
+	}
+	return h
+}
+
+// SetArity - number of children per node, set before adding items
+//  d below 2 is treated as 2
+func (h *DaryHeap[T]) SetArity(d uint) {
+	h.d = max(d, 2)
+}
+
+// Arity - number of children per node
+func (h DaryHeap[T]) Arity() uint {
+	return max(h.d, 2) // zero value heap is binary
+}
+
+// ArraySize - real size of slice as uint
+func (h DaryHeap[T]) ArraySize() uint {
+	return uint(len(h.array))
+}
+
+// Size as uint (logical size for sorting)
+func (h DaryHeap[T]) Size() uint {
+	return h.logicalSize
+}
+
+// Len - alias for Size() except as signed int
+func (h DaryHeap[T]) Len() int {
+	return int(h.Size())
+}
+
+// Value - the item at location i
+func (h DaryHeap[T]) Value(i uint) T {
+	return h.array[i]
+}
+
+// SwapHeapItem - reverse two items in heap
+func (h *DaryHeap[T]) SwapHeapItem(a, b uint) {
+	h.array[a], h.array[b] = h.array[b], h.array[a]
+}
+
+// SetPrioritizeHeapItem - "a > b" or whatever comparison is needed
+func (h *DaryHeap[T]) SetPrioritizeHeapItem(a Prioritize[T]) {
+	h.greater = a
+}
+
+// SetGTIntPrioritizeHeapItem - default "a > b" as ints
+func (h *DaryHeap[T]) SetGTIntPrioritizeHeapItem() {
+	h.greater = func(a, b T) bool {
+		return any(a).(int) > any(b).(int)
+	}
+}
+
+// SetArray copies slice header into DaryHeap
+//  note this means the array items themselves are used in place
+func (h *DaryHeap[T]) SetArray(array []T) {
+	h.array = array
+	h.logicalSize = h.ArraySize()
+}
+
+// MaxHeapify - fix a branch of the heap, moving item i down
+func (h *DaryHeap[T]) MaxHeapify(i uint) {
+	d := h.Arity()
+	for {
+		largest := i
+		first := DaryChild(i, d, 0)
+		for c := first; c < first+d && c < h.Size(); c++ {
+			if h.greater(h.array[c], h.array[largest]) {
+				largest = c
+			}
+		}
+		if largest == i {
+			return
+		}
+		h.SwapHeapItem(i, largest)
+		i = largest
+	}
+}
+
+// BuildMaxHeap - convert unsorted array into Max Heap
+func (h *DaryHeap[T]) BuildMaxHeap() {
+	if h.Size() < 2 {
+		return
+	}
+	last, _ := DaryParent(h.Size()-1, h.Arity())
+	for i := last + 1; i > 0; i-- {
+		// i is 1 based index here to avoid wraparound of uint to uint_max
+		h.MaxHeapify(i - 1) // so adjust to 0 based when used
+	}
+}
+
+// Sort - sort array
+//  initializes heap, sorts, returns sorted slice
+//  if gt is nil, default casts HeapItems as type int
+func (h *DaryHeap[T]) Sort(array []T, gt Prioritize[T]) []T {
+	if array == nil {
+		return nil
+	}
+	h.SetArray(array)
+	if gt == nil {
+		h.SetGTIntPrioritizeHeapItem()
+	} else {
+		h.greater = gt
+	}
+	h.BuildMaxHeap()
+	for i := h.Size(); i > 1; i-- {
+		// i is 1 based index here to avoid wraparound of uint to uint_max
+		h.SwapHeapItem(0, i-1) // so adjust to 0 based when used
+		h.logicalSize--
+		h.MaxHeapify(0)
+	}
+	return h.array
+}
+
+// Insert - add new item to heap, grow if necessary
+func (h *DaryHeap[T]) Insert(key T) {
+	var zero T
+	if h.Size() < h.ArraySize() {
+		h.array[h.logicalSize] = zero
+		h.logicalSize++
+	} else {
+		h.SetArray(append(h.array, zero))
+	}
+	h.ReplaceItem(h.logicalSize-1, key)
+}
+
+// Push - alias for Insert()
+func (h *DaryHeap[T]) Push(key T) {
+	h.Insert(key)
+}
+
+// Maximum - returns prioritzed Item without removing it from heap
+func (h DaryHeap[T]) Maximum() T {
+	return h.array[0]
+}
+
+// Peek - alias for Maximum()
+func (h DaryHeap[T]) Peek() T {
+	return h.Maximum()
+}
+
+// ExtractMax - returns prioritzed Item and removes it from heap
+func (h *DaryHeap[T]) ExtractMax() T {
+	if h.logicalSize < 1 {
+		var zero T
+		return zero
+	}
+	h.logicalSize--
+	h.SwapHeapItem(0, h.logicalSize)
+	h.MaxHeapify(0)
+	return h.array[h.logicalSize]
+}
+
+// Pop - alias for ExtractMax()
+func (h *DaryHeap[T]) Pop() T {
+	return h.ExtractMax()
+}
+
+// ReplaceItem - set item i and restore heap order, whether its priority went up or down
+func (h *DaryHeap[T]) ReplaceItem(i uint, key T) {
+	if i >= h.Size() {
+		return
+	}
+	h.array[i] = key
+	h.MaxHeapify(i)
+	d := h.Arity()
+	for p, err := DaryParent(i, d); err == nil && h.greater(h.array[i], h.array[p]); p, err = DaryParent(i, d) {
+		h.SwapHeapItem(i, p)
+		i = p
+	}
+}
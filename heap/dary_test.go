@@ -0,0 +1,98 @@
+// dary_test.go
+
+package heap
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestDaryHeap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, d := range []uint{0, 2, 3, 4, 8} {
+		// sort
+		items := make([]int, 200)
+		for x := range items {
+			items[x] = r.Intn(100)
+		}
+		want := append([]int(nil), items...)
+		sort.Ints(want)
+		sorter := NewDary[int](d, nil)
+		sorted := sorter.Sort(items, nil)
+		for x := range want {
+			if sorted[x] != want[x] {
+				t.Fatalf("%d: Invalid sort order, found: %d, expected: %d", d, sorted[x], want[x])
+			}
+		}
+
+		// priority queue, with replaced items
+		h := NewDary(d, func(a, b int) bool {
+			return a < b
+		})
+		var ref []int
+		for op := 0; op < 2000; op++ {
+			switch r.Intn(3) {
+			case 0:
+				x := r.Intn(1000)
+				h.Push(x)
+				ref = append(ref, x)
+			case 1:
+				if h.Len() > 0 {
+					i, x := uint(r.Intn(h.Len())), r.Intn(1000)
+					for k := range ref {
+						if ref[k] == h.Value(i) {
+							ref[k] = x
+							break
+						}
+					}
+					h.ReplaceItem(i, x)
+				}
+			case 2:
+				if h.Len() > 0 {
+					sort.Ints(ref)
+					if x := h.Pop(); x != ref[0] {
+						t.Fatalf("%d.%d: Invalid order, found: %d, expected: %d", d, op, x, ref[0])
+					}
+					ref = ref[1:]
+				}
+			}
+		}
+	}
+}
+
+// benchmarkQueue pushes n random items then pops them all
+func benchmarkQueue(b *testing.B, push func(int), pop func() int, n int) {
+	r := rand.New(rand.NewSource(1))
+	items := make([]int, n)
+	for x := range items {
+		items[x] = r.Int()
+	}
+	b.ResetTimer()
+	for range b.N {
+		for _, x := range items {
+			push(x)
+		}
+		for range items {
+			pop()
+		}
+	}
+}
+
+func BenchmarkDaryHeap(b *testing.B) {
+	for _, n := range []int{1000, 100000} {
+		b.Run(fmt.Sprintf("BinaryHeap/n=%d", n), func(b *testing.B) {
+			h := NewMin[int]()
+			benchmarkQueue(b, h.Push, h.Pop, n)
+		})
+		for _, d := range []uint{2, 4, 8} {
+			b.Run(fmt.Sprintf("d=%d/n=%d", d, n), func(b *testing.B) {
+				h := NewDary(d, func(a, b int) bool {
+					return a < b
+				})
+				benchmarkQueue(b, h.Push, h.Pop, n)
+			})
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// dot.go
+
+package heap
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// dotEscaper makes labels safe inside DOT quoted strings
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// WriteDOT writes the implicit tree of the heap array as a Graphviz DOT digraph, to render with e.g. "dot -Tsvg"
+//  label formats each item, fmt.Sprint if nil, nodes are numbered by array index
+//  only items within Size() are drawn, so a sorted tail is left out
+func (h Heap[T]) WriteDOT(w io.Writer, label func(T) string) error {
+	if label == nil {
+		label = func(v T) string {
+			return fmt.Sprint(v)
+		}
+	}
+	var b strings.Builder
+	b.WriteString("digraph heap {\n\tnode [shape=circle];\n")
+	for i := uint(0); i < h.Size(); i++ {
+		fmt.Fprintf(&b, "\tn%d [label=\"%s\", xlabel=\"%d\"];\n", i, dotEscaper.Replace(label(h.array[i])), i)
+		if l := Left(i); l < h.Size() {
+			fmt.Fprintf(&b, "\tn%d -> n%d;\n", i, l)
+		}
+		if r := Right(i); r < h.Size() {
+			fmt.Fprintf(&b, "\tn%d -> n%d;\n", i, r)
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
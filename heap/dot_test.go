@@ -0,0 +1,37 @@
+// dot_test.go
+
+package heap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteDOT(t *testing.T) {
+	h := NewMax[int]()
+	for _, n := range []int{5, 2, 9, 7} {
+		h.Insert(n)
+	}
+	want := `digraph heap {
+	node [shape=circle];
+	n0 [label="9", xlabel="0"];
+	n0 -> n1;
+	n0 -> n2;
+	n1 [label="7", xlabel="1"];
+	n1 -> n3;
+	n2 [label="5", xlabel="2"];
+	n3 [label="2", xlabel="3"];
+}
+`
+	var b strings.Builder
+	if err := h.WriteDOT(&b, nil); err != nil || b.String() != want {
+		t.Errorf("Invalid DOT, found:\n%s\nexpected:\n%s", b.String(), want)
+	}
+
+	// popped items past Size() are left out
+	h.Pop()
+	b.Reset()
+	if err := h.WriteDOT(&b, nil); err != nil || strings.Contains(b.String(), "n3") {
+		t.Errorf("Invalid DOT after pop, found:\n%s", b.String())
+	}
+}
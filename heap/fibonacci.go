@@ -0,0 +1,273 @@
+// fibonacci.go
+
+package heap
+
+// FibonacciNode - item in a FibonacciHeap, also the handle returned by Insert()
+type FibonacciNode[T any] struct {
+	value  T
+	parent *FibonacciNode[T]
+	child  *FibonacciNode[T] // any child, children form a circular list
+	left   *FibonacciNode[T] // circular list of siblings, nil once extracted
+	right  *FibonacciNode[T]
+	degree int  // number of children
+	mark   bool // lost a child since becoming a child itself
+}
+
+// Value - the item held by node
+func (n *FibonacciNode[T]) Value() T {
+	return n.value
+}
+
+// FibonacciHeap - pointer based heap of lazily consolidated trees
+//  Insert, Minimum, Union and DecreaseKey in amortized O(1), ExtractMin and Delete in amortized O(log n)
+//  "minimum" is the prioritized item, so a "a < b" comparison gives the classic min heap
+//
+// Follows pseudocode from "Introduction to Algorithms" by Cormen, Leiserson, Rivest, Stein
+type FibonacciHeap[T any] struct {
+	min     *FibonacciNode[T] // prioritized root, roots form a circular list
+	greater Prioritize[T]
+	size    int
+}
+
+// NewFibonacci returns an empty Fibonacci heap prioritized by gt
+//  if gt is nil, default casts items as type int
+func NewFibonacci[T any](gt Prioritize[T]) *FibonacciHeap[T] {
+	h := &FibonacciHeap[T]{}
+	if gt == nil {
+		h.SetGTIntPrioritizeHeapItem()
+	} else {
+		h.SetPrioritizeHeapItem(gt)
+	}
+	return h
+}
+
+// SetPrioritizeHeapItem - "a < b" for a min heap, or whatever comparison is needed
+func (h *FibonacciHeap[T]) SetPrioritizeHeapItem(a Prioritize[T]) {
+	h.greater = a
+}
+
+// SetGTIntPrioritizeHeapItem - default "a > b" as ints
+func (h *FibonacciHeap[T]) SetGTIntPrioritizeHeapItem() {
+	h.greater = func(a, b T) bool {
+		return any(a).(int) > any(b).(int)
+	}
+}
+
+// Len returns number of items in heap
+func (h FibonacciHeap[T]) Len() int {
+	return h.size
+}
+
+// spliceIn adds n to the circular list of x, right of x
+func spliceIn[T any](x, n *FibonacciNode[T]) {
+	n.left = x
+	n.right = x.right
+	x.right.left = n
+	x.right = n
+}
+
+// unlink removes n from its circular list, leaving it a list of one
+func unlink[T any](n *FibonacciNode[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+	n.left, n.right = n, n
+}
+
+// addRoot puts a lone node n in the root list, updating min
+func (h *FibonacciHeap[T]) addRoot(n *FibonacciNode[T]) {
+	n.parent = nil
+	n.mark = false
+	if h.min == nil {
+		n.left, n.right = n, n
+		h.min = n
+		return
+	}
+	spliceIn(h.min, n)
+	if h.greater(n.value, h.min.value) {
+		h.min = n
+	}
+}
+
+// Insert - add new item to heap, returns its node as a handle
+func (h *FibonacciHeap[T]) Insert(key T) *FibonacciNode[T] {
+	n := &FibonacciNode[T]{value: key}
+	h.addRoot(n)
+	h.size++
+	return n
+}
+
+// Minimum - returns prioritized item without removing it, zero value if heap is empty
+func (h FibonacciHeap[T]) Minimum() T {
+	if h.min == nil {
+		var zero T
+		return zero
+	}
+	return h.min.value
+}
+
+// siblings lists n and the rest of its circular list
+func siblings[T any](n *FibonacciNode[T]) []*FibonacciNode[T] {
+	var list []*FibonacciNode[T]
+	if n == nil {
+		return list
+	}
+	x := n
+	for {
+		list = append(list, x)
+		x = x.right
+		if x == n {
+			return list
+		}
+	}
+}
+
+// ExtractMin - returns prioritized item and removes it from heap, zero value if heap is empty
+func (h *FibonacciHeap[T]) ExtractMin() T {
+	z := h.min
+	if z == nil {
+		var zero T
+		return zero
+	}
+	for _, c := range siblings(z.child) {
+		c.left, c.right = c, c
+		c.parent = nil
+		c.mark = false
+		spliceIn(z, c)
+	}
+	z.child = nil
+	z.degree = 0
+	if z.right == z {
+		h.min = nil
+	} else {
+		h.min = z.right
+		unlink(z)
+		h.consolidate()
+	}
+	h.size--
+
+	z.left, z.right = nil, nil // no longer in heap
+	return z.value
+}
+
+// link makes root y a child of root x
+func (h *FibonacciHeap[T]) link(y, x *FibonacciNode[T]) {
+	y.left, y.right = y, y
+	if x.child == nil {
+		x.child = y
+	} else {
+		spliceIn(x.child, y)
+	}
+	y.parent = x
+	y.mark = false
+	x.degree++
+}
+
+// consolidate links roots of same degree until all degrees are distinct
+func (h *FibonacciHeap[T]) consolidate() {
+	var byDegree []*FibonacciNode[T]
+	for _, x := range siblings(h.min) {
+		d := x.degree
+		for d < len(byDegree) && byDegree[d] != nil {
+			y := byDegree[d]
+			if h.greater(y.value, x.value) {
+				x, y = y, x
+			}
+			h.link(y, x)
+			byDegree[d] = nil
+			d++
+		}
+		for len(byDegree) <= d {
+			byDegree = append(byDegree, nil)
+		}
+		byDegree[d] = x
+	}
+
+	h.min = nil
+	for _, x := range byDegree {
+		if x != nil {
+			h.addRoot(x)
+		}
+	}
+}
+
+// cut moves x from the children of y to the root list
+func (h *FibonacciHeap[T]) cut(x, y *FibonacciNode[T]) {
+	if x.right == x {
+		y.child = nil
+	} else {
+		if y.child == x {
+			y.child = x.right
+		}
+		unlink(x)
+	}
+	y.degree--
+	h.addRoot(x)
+}
+
+// cascadingCut cuts y too if it already lost a child, and so on upwards
+func (h *FibonacciHeap[T]) cascadingCut(y *FibonacciNode[T]) {
+	for z := y.parent; z != nil; z = y.parent {
+		if !y.mark {
+			y.mark = true
+			return
+		}
+		h.cut(y, z)
+		y = z
+	}
+}
+
+// DecreaseKey - moves node n to a key of higher priority, a decrease for min heaps
+//  returns false, leaving n unchanged, if key has lower priority or n is not in heap
+func (h *FibonacciHeap[T]) DecreaseKey(n *FibonacciNode[T], key T) bool {
+	if n == nil || n.left == nil || h.greater(n.value, key) {
+		return false
+	}
+	n.value = key
+	if y := n.parent; y != nil && h.greater(n.value, y.value) {
+		h.cut(n, y)
+		h.cascadingCut(y)
+	}
+	if h.greater(n.value, h.min.value) {
+		h.min = n
+	}
+	return true
+}
+
+// Delete removes node n from heap, returns false if n is not in heap
+func (h *FibonacciHeap[T]) Delete(n *FibonacciNode[T]) bool {
+	if n == nil || n.left == nil {
+		return false
+	}
+	if y := n.parent; y != nil {
+		h.cut(n, y)
+		h.cascadingCut(y)
+	}
+	h.min = n // as if decreased below all others
+	h.ExtractMin()
+	return true
+}
+
+// Union moves all items of other into h in O(1), leaving other empty
+//  handles from other stay valid in h
+func (h *FibonacciHeap[T]) Union(other *FibonacciHeap[T]) {
+	if other == h || other.min == nil {
+		return
+	}
+	if h.min == nil {
+		h.min = other.min
+	} else {
+		// join the two circular root lists
+		a, b := h.min, other.min
+		aRight, bLeft := a.right, b.left
+		a.right = b
+		b.left = a
+		bLeft.right = aRight
+		aRight.left = bLeft
+		if h.greater(b.value, a.value) {
+			h.min = b
+		}
+	}
+	h.size += other.size
+	other.min = nil
+	other.size = 0
+}
@@ -0,0 +1,106 @@
+// fibonacci_test.go
+
+package heap
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestFibonacciHeap runs random operation sequences on a FibonacciHeap and a BinaryHeap
+// with the same comparison, expecting the same items out in the same order
+func TestFibonacciHeap(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		fib := NewFibonacci(minInt)
+		bin := NewMin[int]()
+		handles := make(map[int]*FibonacciNode[int]) // distinct keys to their handles
+
+		// find locates key in bin, which has no handles
+		find := func(key int) uint {
+			for i := uint(0); i < bin.Size(); i++ {
+				if bin.Value(i) == key {
+					return i
+				}
+			}
+			t.Fatalf("%d: Key %d not in BinaryHeap", seed, key)
+			return 0
+		}
+		insert := func(key int) {
+			if _, ok := handles[key]; !ok {
+				handles[key] = fib.Insert(key)
+				bin.Insert(key)
+			}
+		}
+
+		for op := 0; op < 1000; op++ {
+			switch r.Intn(7) {
+			case 0, 1, 2:
+				insert(r.Intn(100000))
+			case 3:
+				if fib.Len() > 0 {
+					if fib.Minimum() != bin.Maximum() {
+						t.Fatalf("%d.%d: Invalid minimum, found: %d, expected: %d", seed, op, fib.Minimum(), bin.Maximum())
+					}
+					x, y := fib.ExtractMin(), bin.ExtractMax()
+					if x != y {
+						t.Fatalf("%d.%d: Invalid order, found: %d, expected: %d", seed, op, x, y)
+					}
+					if fib.DecreaseKey(handles[x], -1) || fib.Delete(handles[x]) {
+						t.Fatalf("%d.%d: Extracted node still in heap", seed, op)
+					}
+					delete(handles, x)
+				}
+			case 4:
+				for key, n := range handles {
+					lower := key - 1 - r.Intn(1000)
+					if _, ok := handles[lower]; ok {
+						break
+					}
+					if fib.DecreaseKey(n, key+1) || !fib.DecreaseKey(n, lower) {
+						t.Fatalf("%d.%d: Invalid DecreaseKey result for %d", seed, op, key)
+					}
+					bin.ReplaceItem(find(key), lower)
+					delete(handles, key)
+					handles[lower] = n
+					break
+				}
+			case 5:
+				for key, n := range handles {
+					if !fib.Delete(n) {
+						t.Fatalf("%d.%d: Delete of %d failed", seed, op, key)
+					}
+					bin.Remove(find(key))
+					delete(handles, key)
+					break
+				}
+			case 6:
+				other := NewFibonacci(minInt)
+				for range r.Intn(10) {
+					key := r.Intn(100000)
+					if _, ok := handles[key]; !ok {
+						handles[key] = other.Insert(key)
+						bin.Insert(key)
+					}
+				}
+				fib.Union(other)
+				if other.Len() != 0 {
+					t.Fatalf("%d.%d: United heap not empty", seed, op)
+				}
+			}
+
+			if fib.Len() != bin.Len() {
+				t.Fatalf("%d.%d: Invalid length, found: %d, expected: %d", seed, op, fib.Len(), bin.Len())
+			}
+		}
+
+		for bin.Len() > 0 {
+			if x, y := fib.ExtractMin(), bin.ExtractMax(); x != y {
+				t.Fatalf("%d: Invalid final order, found: %d, expected: %d", seed, x, y)
+			}
+		}
+		if fib.Len() != 0 || fib.ExtractMin() != 0 {
+			t.Errorf("%d: Emptied heap returned items", seed)
+		}
+	}
+}
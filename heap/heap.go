@@ -2,21 +2,60 @@
 
 package heap
 
-import "errors"
+import (
+	"cmp"
+	"errors"
+
+	"github.com/PuppyKhan/jebe/collection"
+)
 
 // Item - the type to be sorted
 type Item interface{}
 
+// Prioritize - custom comparison for prioritizing heap items of type T
+//  basic max heap would need "a > b" ("a < b" for min heap)
+type Prioritize[T any] func(a, b T) bool
+
 // PrioritizeHeapItem - custom comparison for prioritizing heap items
 //  basic max heap would need "a > b" ("a < b" for min heap)
 //  maybe create a default? https://newfivefour.com/golang-interface-type-assertions-switch.html
-type PrioritizeHeapItem func(a, b Item) bool
+type PrioritizeHeapItem = Prioritize[Item]
 
-// BinaryHeap - the basic heap with a slice and a comparison method
-type BinaryHeap struct {
-	array       []Item
-	greater     PrioritizeHeapItem
+// Heap - the basic heap with a slice and a comparison method, for items of type T
+type Heap[T any] struct {
+	array       []T
+	greater     Prioritize[T]
 	logicalSize uint
+	codec       collection.Codec[T] // items for MarshalBinary, GobCodec if not set
+}
+
+// BinaryHeap - heap of Items, the interface{} API over the generic Heap
+type BinaryHeap = Heap[Item]
+
+// New returns an empty heap prioritized by gt
+//  if gt is nil, default casts items as type int
+func New[T any](gt Prioritize[T]) *Heap[T] {
+	h := &Heap[T]{}
+	if gt == nil {
+		h.SetGTIntPrioritizeHeapItem()
+	} else {
+		h.SetPrioritizeHeapItem(gt)
+	}
+	return h
+}
+
+// NewMax returns an empty max heap of ordered items
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return New(func(a, b T) bool {
+		return a > b
+	})
+}
+
+// NewMin returns an empty min heap of ordered items
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return New(func(a, b T) bool {
+		return a < b
+	})
 }
 
 // Parent node number of node i
@@ -38,49 +77,49 @@ func Right(i uint) uint {
 }
 
 // ArraySize - real size of slice as uint
-func (h BinaryHeap) ArraySize() uint {
+func (h Heap[T]) ArraySize() uint {
 	return uint(len(h.array))
 }
 
 // Size as uint (logical size for sorting)
-func (h BinaryHeap) Size() uint {
+func (h Heap[T]) Size() uint {
 	return h.logicalSize
 }
 
 // Value - the item at location i
-func (h BinaryHeap) Value(i uint) Item {
+func (h Heap[T]) Value(i uint) T {
 	return h.array[i]
 }
 
 // SwapHeapItem - reverse two items in heap
-func (h *BinaryHeap) SwapHeapItem(a, b uint) {
+func (h *Heap[T]) SwapHeapItem(a, b uint) {
 	tmp := h.array[a]
 	h.array[a] = h.array[b]
 	h.array[b] = tmp
 }
 
 // SetPrioritizeHeapItem - "a > b" or whatever comparison is needed
-func (h *BinaryHeap) SetPrioritizeHeapItem(a PrioritizeHeapItem) {
+func (h *Heap[T]) SetPrioritizeHeapItem(a Prioritize[T]) {
 	h.greater = a
 }
 
 // SetGTIntPrioritizeHeapItem - default "a > b" as ints
-func (h *BinaryHeap) SetGTIntPrioritizeHeapItem() {
-	h.greater = func(a, b Item) bool {
-		return a.(int) > b.(int)
+func (h *Heap[T]) SetGTIntPrioritizeHeapItem() {
+	h.greater = func(a, b T) bool {
+		return any(a).(int) > any(b).(int)
 	}
 }
 
 // SetArray copies slice header into BinaryHeap
 //  note this means the array items themselves are used in place
-func (h *BinaryHeap) SetArray(array []Item) {
+func (h *Heap[T]) SetArray(array []T) {
 	h.array = array
 	h.logicalSize = h.ArraySize()
 }
 
 // MaxHeapify - fix a branch of the heap
 //  NOTE: uses 0 based array indexing
-func (h *BinaryHeap) MaxHeapify(i uint) {
+func (h *Heap[T]) MaxHeapify(i uint) {
 	l := Left(i)
 	r := Right(i)
 	largest := i
@@ -97,7 +136,7 @@ func (h *BinaryHeap) MaxHeapify(i uint) {
 }
 
 // BuildMaxHeap - convert unsorted array into Max Heap
-func (h *BinaryHeap) BuildMaxHeap() {
+func (h *Heap[T]) BuildMaxHeap() {
 	length := h.Size()
 	for i := (length / 2); i > 0; i-- {
 		// i is 1 based index here to avoid wraparound of uint to uint_max
@@ -108,7 +147,7 @@ func (h *BinaryHeap) BuildMaxHeap() {
 // Sort - sort array
 //  initializes heap, sorts, returns sorted slice
 //  if gt is nil, default casts HeapItems as type int
-func (h *BinaryHeap) Sort(array []Item, gt PrioritizeHeapItem) []Item {
+func (h *Heap[T]) Sort(array []T, gt Prioritize[T]) []T {
 	if array == nil {
 		return nil
 	}
@@ -131,35 +170,37 @@ func (h *BinaryHeap) Sort(array []Item, gt PrioritizeHeapItem) []Item {
 // Using heap as a priority queue
 
 // Insert - add new item to heap, grow if necessary
-func (h *BinaryHeap) Insert(key Item) {
+func (h *Heap[T]) Insert(key T) {
+	var zero T
 	if h.Size() < h.ArraySize() {
-		h.array[h.logicalSize] = nil
+		h.array[h.logicalSize] = zero
 		h.logicalSize++
 	} else {
-		h.SetArray(append(h.array, nil))
+		h.SetArray(append(h.array, zero))
 	}
 	h.ReplaceItem(h.logicalSize-1, key)
 }
 
 // Push - alias for Insert()
-func (h *BinaryHeap) Push(key Item) {
+func (h *Heap[T]) Push(key T) {
 	h.Insert(key)
 }
 
 // Maximum - returns prioritzed Item without removing it from heap
-func (h BinaryHeap) Maximum() Item {
+func (h Heap[T]) Maximum() T {
 	return h.array[0]
 }
 
 // Peek - alias for Maximum()
-func (h BinaryHeap) Peek() Item {
+func (h Heap[T]) Peek() T {
 	return h.Maximum()
 }
 
 // ExtractMax - returns prioritzed Item and removes it from heap
-func (h *BinaryHeap) ExtractMax() Item {
+func (h *Heap[T]) ExtractMax() T {
 	if h.logicalSize < 1 {
-		return nil
+		var zero T
+		return zero
 	}
 	h.logicalSize--
 	h.SwapHeapItem(0, h.logicalSize)
@@ -168,12 +209,42 @@ func (h *BinaryHeap) ExtractMax() Item {
 }
 
 // Pop - alias for ExtractMax()
-func (h *BinaryHeap) Pop() Item {
+func (h *Heap[T]) Pop() T {
 	return h.ExtractMax()
 }
 
+// Init - restore heap order of all items, after changing them in place
+func (h *Heap[T]) Init() {
+	h.BuildMaxHeap()
+}
+
+// Fix - restore heap order after item i changed priority, higher or lower
+func (h *Heap[T]) Fix(i uint) {
+	if i >= h.Size() {
+		return
+	}
+	h.MaxHeapify(i)
+	for p, err := Parent(i); err == nil && h.greater(h.array[i], h.array[p]); p, err = Parent(i) {
+		h.SwapHeapItem(i, p)
+		i = p
+	}
+}
+
+// Remove - returns item i and removes it from heap
+//  like ExtractMax(), the removed item is left just past the logical size
+func (h *Heap[T]) Remove(i uint) T {
+	if i >= h.Size() {
+		var zero T
+		return zero
+	}
+	h.logicalSize--
+	h.SwapHeapItem(i, h.logicalSize)
+	h.Fix(i)
+	return h.array[h.logicalSize]
+}
+
 // ReplaceItem - (instead of IncreaseKey)
-func (h *BinaryHeap) ReplaceItem(i uint, key Item) {
+func (h *Heap[T]) ReplaceItem(i uint, key T) {
 	if i >= h.Size() { // ArraySize() instead?
 		return
 	}
@@ -186,16 +257,16 @@ func (h *BinaryHeap) ReplaceItem(i uint, key Item) {
 // For satisfying sort.Interface
 
 // Len - alias for Size() except as signed int
-func (h BinaryHeap) Len() int {
+func (h Heap[T]) Len() int {
 	return int(h.Size())
 }
 
 // Swap - alias for SwapHeapItem() except as signed ints
-func (h *BinaryHeap) Swap(a, b int) {
+func (h *Heap[T]) Swap(a, b int) {
 	h.SwapHeapItem(uint(a), uint(b))
 }
 
-// Less - actually returns <= (aka: not >) so may be less optimal for such use
-func (h *BinaryHeap) Less(a, b int) bool {
-	return !h.greater(h.array[a], h.array[b])
+// Less - item a is prioritized below item b, so sorts in same order as Sort()
+func (h *Heap[T]) Less(a, b int) bool {
+	return h.greater(h.array[b], h.array[a])
 }
@@ -148,3 +148,54 @@ func TestPriorityQ(t *testing.T) {
 		}
 	}
 }
+
+func TestGenericHeap(t *testing.T) {
+	// typed sort, no assertions needed in comparison
+	var sorter Heap[int]
+	sorted := sorter.Sort([]int{23, 13, 55, 10, 6, 99, 22}, func(a, b int) bool {
+		return a > b
+	})
+	want := []int{6, 10, 13, 22, 23, 55, 99}
+	for x := range want {
+		if sorted[x] != want[x] {
+			t.Errorf("sort: Invalid order, found: %d, expected: %d", sorted[x], want[x])
+		}
+	}
+
+	tests := []struct {
+		givenHeap  *Heap[string]
+		givenArray []string
+
+		wantOutput []string
+	}{
+		{
+			NewMax[string](),
+			[]string{"kenny", "kyle", "eric", "chef", "stan"},
+
+			[]string{"stan", "kyle", "kenny", "eric", "chef"},
+		},
+		{
+			NewMin[string](),
+			[]string{"kenny", "kyle", "eric", "chef", "stan"},
+
+			[]string{"chef", "eric", "kenny", "kyle", "stan"},
+		},
+	}
+
+	for i, test := range tests {
+		for _, s := range test.givenArray {
+			test.givenHeap.Push(s)
+		}
+		if test.givenHeap.Peek() != test.wantOutput[0] {
+			t.Errorf("%d: Invalid peek, found: %s, expected: %s", i, test.givenHeap.Peek(), test.wantOutput[0])
+		}
+		for n, want := range test.wantOutput {
+			if m := test.givenHeap.Pop(); m != want {
+				t.Errorf("%d.%d: Invalid order, found: %s, expected: %s", i, n, m, want)
+			}
+		}
+		if m := test.givenHeap.ExtractMax(); m != "" {
+			t.Errorf("%d: Empty heap returned %s, expected zero value", i, m)
+		}
+	}
+}
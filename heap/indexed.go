@@ -0,0 +1,168 @@
+// indexed.go
+
+package heap
+
+// Handle - stable reference to an item pushed on an IndexedHeap
+//  handles start at 1, so the zero Handle never refers to an item
+type Handle uint64
+
+// indexedItem - heap entry remembering its handle
+type indexedItem[T any] struct {
+	key    T
+	handle Handle
+}
+
+// IndexedHeap - priority queue whose items can be found, updated and removed by handle
+//  position of each handle is tracked through every swap
+type IndexedHeap[T any] struct {
+	array    []indexedItem[T]
+	position map[Handle]uint
+	greater  Prioritize[T]
+	last     Handle
+}
+
+// NewIndexed returns an empty indexed heap prioritized by gt
+//  if gt is nil, default casts items as type int
+func NewIndexed[T any](gt Prioritize[T]) *IndexedHeap[T] {
+	h := &IndexedHeap[T]{}
+	if gt == nil {
+		h.SetGTIntPrioritizeHeapItem()
+	} else {
+		h.SetPrioritizeHeapItem(gt)
+	}
+	return h
+}
+
+// SetPrioritizeHeapItem - "a > b" or whatever comparison is needed
+func (h *IndexedHeap[T]) SetPrioritizeHeapItem(a Prioritize[T]) {
+	h.greater = a
+}
+
+// SetGTIntPrioritizeHeapItem - default "a > b" as ints
+func (h *IndexedHeap[T]) SetGTIntPrioritizeHeapItem() {
+	h.greater = func(a, b T) bool {
+		return any(a).(int) > any(b).(int)
+	}
+}
+
+// Len returns number of items in heap
+func (h IndexedHeap[T]) Len() int {
+	return len(h.array)
+}
+
+// swap reverses two items in heap, keeping their positions current
+func (h *IndexedHeap[T]) swap(a, b uint) {
+	h.array[a], h.array[b] = h.array[b], h.array[a]
+	h.position[h.array[a].handle] = a
+	h.position[h.array[b].handle] = b
+}
+
+// up moves item i towards the root until its parent has priority
+func (h *IndexedHeap[T]) up(i uint) {
+	for p, err := Parent(i); err == nil && h.greater(h.array[i].key, h.array[p].key); p, err = Parent(i) {
+		h.swap(i, p)
+		i = p
+	}
+}
+
+// down moves item i towards the leaves until it has priority over its children
+func (h *IndexedHeap[T]) down(i uint) {
+	size := uint(len(h.array))
+	for {
+		l := Left(i)
+		r := Right(i)
+		largest := i
+		if l < size && h.greater(h.array[l].key, h.array[largest].key) {
+			largest = l
+		}
+		if r < size && h.greater(h.array[r].key, h.array[largest].key) {
+			largest = r
+		}
+		if largest == i {
+			return
+		}
+		h.swap(i, largest)
+		i = largest
+	}
+}
+
+// Push adds new item to heap, returns its handle
+func (h *IndexedHeap[T]) Push(key T) Handle {
+	if h.position == nil {
+		h.position = make(map[Handle]uint)
+	}
+	h.last++
+	i := uint(len(h.array))
+	h.array = append(h.array, indexedItem[T]{key: key, handle: h.last})
+	h.position[h.last] = i
+	h.up(i)
+	return h.last
+}
+
+// Peek returns prioritized item and its handle without removing it
+//  zero Handle if heap is empty
+func (h IndexedHeap[T]) Peek() (T, Handle) {
+	if len(h.array) == 0 {
+		var zero T
+		return zero, 0
+	}
+	return h.array[0].key, h.array[0].handle
+}
+
+// Pop returns prioritized item and its handle and removes it from heap
+//  zero Handle if heap is empty
+func (h *IndexedHeap[T]) Pop() (T, Handle) {
+	key, handle := h.Peek()
+	if handle != 0 {
+		h.Remove(handle)
+	}
+	return key, handle
+}
+
+// Contains returns whether handle refers to an item still in heap
+func (h IndexedHeap[T]) Contains(handle Handle) bool {
+	_, ok := h.position[handle]
+	return ok
+}
+
+// Get returns item of handle and whether it is still in heap
+func (h IndexedHeap[T]) Get(handle Handle) (T, bool) {
+	i, ok := h.position[handle]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return h.array[i].key, true
+}
+
+// Update replaces item of handle and restores heap order
+//  serves as both IncreaseKey and DecreaseKey, returns false if handle is not in heap
+func (h *IndexedHeap[T]) Update(handle Handle, key T) bool {
+	i, ok := h.position[handle]
+	if !ok {
+		return false
+	}
+	h.array[i].key = key
+	h.up(i)
+	h.down(h.position[handle])
+	return true
+}
+
+// Remove deletes item of handle from heap, returns false if handle is not in heap
+func (h *IndexedHeap[T]) Remove(handle Handle) bool {
+	i, ok := h.position[handle]
+	if !ok {
+		return false
+	}
+	last := uint(len(h.array) - 1)
+	h.swap(i, last)
+	h.array[last] = indexedItem[T]{} // don't hold on to removed item
+	h.array = h.array[:last]
+	delete(h.position, handle)
+	if i < last { // last item moved into the gap
+		moved := h.array[i].handle
+		h.up(i)
+		h.down(h.position[moved])
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+// indexed_test.go
+
+package heap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestIndexedHeap(t *testing.T) {
+	// min heap, as for shortest paths
+	h := NewIndexed(func(a, b int) bool {
+		return a < b
+	})
+	if _, handle := h.Pop(); handle != 0 {
+		t.Errorf("Empty heap returned handle %d", handle)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	want := map[Handle]int{} // items expected in heap
+	for op := 0; op < 2000; op++ {
+		switch r.Intn(4) {
+		case 0, 1:
+			key := r.Intn(1000)
+			want[h.Push(key)] = key
+		case 2:
+			for handle := range want {
+				key := r.Intn(1000)
+				if !h.Update(handle, key) {
+					t.Fatalf("%d: Update of %d failed", op, handle)
+				}
+				want[handle] = key
+				break
+			}
+		case 3:
+			for handle := range want {
+				if !h.Remove(handle) {
+					t.Fatalf("%d: Remove of %d failed", op, handle)
+				}
+				if h.Contains(handle) || h.Remove(handle) || h.Update(handle, 0) {
+					t.Fatalf("%d: Removed handle %d still in heap", op, handle)
+				}
+				delete(want, handle)
+				break
+			}
+		}
+		if h.Len() != len(want) {
+			t.Fatalf("%d: Invalid length, found: %d, expected: %d", op, h.Len(), len(want))
+		}
+	}
+
+	for handle, key := range want {
+		if k, ok := h.Get(handle); !ok || k != key {
+			t.Errorf("Invalid item of %d, found: %d, expected: %d", handle, k, key)
+		}
+	}
+
+	keys := make([]int, 0, len(want))
+	for _, key := range want {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	for n, key := range keys {
+		k, handle := h.Pop()
+		if k != key || want[handle] != key {
+			t.Fatalf("%d: Invalid order, found: %d, expected: %d", n, k, key)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// json.go
+
+package heap
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// MarshalJSON encodes the Size() items of heap as an array in priority order, highest first
+//  pops from a copy, so heap is unchanged
+func (h Heap[T]) MarshalJSON() ([]byte, error) {
+	c := Heap[T]{greater: h.greater}
+	c.SetArray(slices.Clone(h.array[:h.Size()]))
+	items := make([]T, 0, c.Size())
+	for c.Size() > 0 {
+		items = append(items, c.ExtractMax())
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON replaces contents of heap with items of a JSON array in any order, rebuilding heap order
+//  comparison must be set first, else ErrNoComparison is returned
+//  numbers decode as float64 into Items, so the comparison must handle those
+func (h *Heap[T]) UnmarshalJSON(data []byte) error {
+	if h.greater == nil {
+		return ErrNoComparison
+	}
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	h.SetArray(items)
+	h.BuildMaxHeap()
+	return nil
+}
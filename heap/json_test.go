@@ -0,0 +1,61 @@
+// json_test.go
+
+package heap
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	h := NewMax[int]()
+	for _, n := range []int{5, 2, 9, 7, 1} {
+		h.Insert(n)
+	}
+	h.Pop() // left out, past Size()
+	before := slices.Clone(h.array)
+	got, err := json.Marshal(h)
+	if want := "[7,5,2,1]"; err != nil || string(got) != want {
+		t.Errorf("Invalid JSON, found: %s, expected: %s", got, want)
+	}
+	if !slices.Equal(h.array, before) || h.Size() != 4 {
+		t.Errorf("Heap changed, found: %v, expected: %v", h.array, before)
+	}
+	got, err = json.Marshal(NewMin[int]())
+	if err != nil || string(got) != "[]" {
+		t.Errorf("Invalid empty JSON, found: %s", got)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	h := NewMin[int]()
+	h.Insert(42) // replaced
+	if err := json.Unmarshal([]byte("[5,2,9,7,1]"), h); err != nil {
+		t.Fatal(err)
+	}
+	var got []int
+	for h.Size() > 0 {
+		got = append(got, h.Pop())
+	}
+	if want := []int{1, 2, 5, 7, 9}; !slices.Equal(got, want) {
+		t.Errorf("Invalid order, found: %v, expected: %v", got, want)
+	}
+
+	// no comparison to rebuild with
+	var zero BinaryHeap
+	if err := json.Unmarshal([]byte("[3,1,2]"), &zero); err != ErrNoComparison {
+		t.Errorf("Missing comparison not reported, found: %v", err)
+	}
+
+	// Items decode numbers as float64
+	items := New(func(a, b Item) bool {
+		return a.(float64) > b.(float64)
+	})
+	if err := json.Unmarshal([]byte("[3,1.5,2]"), items); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := items.Pop(), Item(3.0); got != want {
+		t.Errorf("Invalid maximum, found: %v, expected: %v", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+// mergeable_test.go
+
+package heap
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// mergeable - methods shared by PairingHeap and BinomialHeap, for testing both
+type mergeable[H any, N comparable] interface {
+	Insert(key int) N
+	PeekMax() int
+	ExtractMax() int
+	DecreaseKey(n N, key int) bool
+	Meld(other H)
+	Len() int
+}
+
+// testMergeable runs random operations on a min heap against a map of expected handles
+//  keys are kept distinct so extracted items can be matched to their handles
+func testMergeable[H mergeable[H, N], N comparable](t *testing.T, newHeap func() H) {
+	r := rand.New(rand.NewSource(1))
+	h := newHeap()
+	want := make(map[N]int)
+	used := make(map[int]bool)
+	key := func() int {
+		for {
+			if k := r.Intn(1000000); !used[k] {
+				used[k] = true
+				return k
+			}
+		}
+	}
+
+	for op := 0; op < 3000; op++ {
+		switch r.Intn(6) {
+		case 0, 1:
+			k := key()
+			want[h.Insert(k)] = k
+		case 2:
+			if len(want) > 0 {
+				var least N
+				for n, k := range want {
+					if _, ok := want[least]; !ok || k < want[least] {
+						least = n
+					}
+				}
+				if x := h.ExtractMax(); x != want[least] {
+					t.Fatalf("%d: Invalid order, found: %d, expected: %d", op, x, want[least])
+				}
+				delete(want, least)
+				if h.DecreaseKey(least, -1) {
+					t.Fatalf("%d: Extracted handle still in heap", op)
+				}
+			}
+		case 3, 4:
+			for n, k := range want {
+				if h.DecreaseKey(n, k+1) {
+					t.Fatalf("%d: Lowered priority of %d", op, k)
+				}
+				lower := k - 1 - r.Intn(1000)
+				if used[lower] {
+					break
+				}
+				used[lower] = true
+				if !h.DecreaseKey(n, lower) {
+					t.Fatalf("%d: DecreaseKey of %d failed", op, k)
+				}
+				want[n] = lower
+				break
+			}
+		case 5:
+			other := newHeap()
+			for range r.Intn(10) {
+				k := key()
+				want[other.Insert(k)] = k
+			}
+			h.Meld(other)
+			if other.Len() != 0 {
+				t.Fatalf("%d: Melded heap not empty", op)
+			}
+		}
+
+		if h.Len() != len(want) {
+			t.Fatalf("%d: Invalid length, found: %d, expected: %d", op, h.Len(), len(want))
+		}
+	}
+
+	for last := -1 << 31; h.Len() > 0; {
+		x := h.ExtractMax()
+		if x < last {
+			t.Fatalf("Invalid final order, found: %d after: %d", x, last)
+		}
+		last = x
+	}
+	if h.ExtractMax() != 0 || h.PeekMax() != 0 {
+		t.Errorf("Empty heap returned items")
+	}
+}
+
+func minInt(a, b int) bool {
+	return a < b
+}
+
+func TestPairingHeap(t *testing.T) {
+	testMergeable(t, func() *PairingHeap[int] {
+		return NewPairing(minInt)
+	})
+}
+
+func TestBinomialHeap(t *testing.T) {
+	testMergeable(t, func() *BinomialHeap[int] {
+		return NewBinomial(minInt)
+	})
+}
@@ -0,0 +1,183 @@
+// minmax.go
+
+package heap
+
+import "math/bits"
+
+// MinMaxHeap - double ended priority queue, both lowest and highest priority items are on top
+//  levels alternate between min levels (even, root is 0) and max levels (odd)
+//  each item on a min level is the lowest of its branch, on a max level the highest
+type MinMaxHeap[T any] struct {
+	array   []T
+	greater Prioritize[T]
+}
+
+// NewMinMax returns an empty min-max heap prioritized by gt
+//  if gt is nil, default casts items as type int
+func NewMinMax[T any](gt Prioritize[T]) *MinMaxHeap[T] {
+	h := &MinMaxHeap[T]{}
+	if gt == nil {
+		h.SetGTIntPrioritizeHeapItem()
+	} else {
+		h.SetPrioritizeHeapItem(gt)
+	}
+	return h
+}
+
+// SetPrioritizeHeapItem - "a > b" or whatever comparison is needed
+func (h *MinMaxHeap[T]) SetPrioritizeHeapItem(a Prioritize[T]) {
+	h.greater = a
+}
+
+// SetGTIntPrioritizeHeapItem - default "a > b" as ints
+func (h *MinMaxHeap[T]) SetGTIntPrioritizeHeapItem() {
+	h.greater = func(a, b T) bool {
+		return any(a).(int) > any(b).(int)
+	}
+}
+
+// Len returns number of items in heap
+func (h MinMaxHeap[T]) Len() int {
+	return len(h.array)
+}
+
+// isMinLevel - whether node i is on a min level
+func isMinLevel(i uint) bool {
+	return (bits.Len(i+1)-1)%2 == 0
+}
+
+// before - whether item a belongs above item b on node i's level
+func (h MinMaxHeap[T]) before(i uint, a, b T) bool {
+	if isMinLevel(i) {
+		return h.greater(b, a)
+	}
+	return h.greater(a, b)
+}
+
+// swap reverses two items in heap
+func (h *MinMaxHeap[T]) swap(a, b uint) {
+	h.array[a], h.array[b] = h.array[b], h.array[a]
+}
+
+// Insert - add new item to heap
+func (h *MinMaxHeap[T]) Insert(key T) {
+	h.array = append(h.array, key)
+	i := uint(len(h.array) - 1)
+	p, err := Parent(i)
+	if err != nil {
+		return
+	}
+	if h.before(p, key, h.array[p]) {
+		// belongs on the other kind of level, above its parent
+		h.swap(i, p)
+		i = p
+	}
+	h.bubbleUp(i)
+}
+
+// Push - alias for Insert()
+func (h *MinMaxHeap[T]) Push(key T) {
+	h.Insert(key)
+}
+
+// bubbleUp moves item i up through grandparents on its own kind of level
+func (h *MinMaxHeap[T]) bubbleUp(i uint) {
+	for i > 2 {
+		p, _ := Parent(i)
+		g, _ := Parent(p)
+		if !h.before(i, h.array[i], h.array[g]) {
+			return
+		}
+		h.swap(i, g)
+		i = g
+	}
+}
+
+// trickleDown moves item i down through grandchildren on its own kind of level
+func (h *MinMaxHeap[T]) trickleDown(i uint) {
+	size := uint(len(h.array))
+	for Left(i) < size {
+		// first of children and grandchildren, for i's level
+		m := Left(i)
+		for _, c := range []uint{Right(i), Left(Left(i)), Right(Left(i)), Left(Right(i)), Right(Right(i))} {
+			if c < size && h.before(i, h.array[c], h.array[m]) {
+				m = c
+			}
+		}
+		if !h.before(i, h.array[m], h.array[i]) {
+			return
+		}
+		h.swap(i, m)
+		if m <= Right(i) {
+			return // child, on the other kind of level
+		}
+		if p, _ := Parent(m); h.before(p, h.array[m], h.array[p]) {
+			h.swap(m, p)
+		}
+		i = m
+	}
+}
+
+// maxIndex - node holding highest priority item, on the first max level if any
+func (h MinMaxHeap[T]) maxIndex() uint {
+	switch len(h.array) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if h.greater(h.array[2], h.array[1]) {
+		return 2
+	}
+	return 1
+}
+
+// PeekMin returns lowest priority item without removing it, zero value if heap is empty
+func (h MinMaxHeap[T]) PeekMin() T {
+	if len(h.array) == 0 {
+		var zero T
+		return zero
+	}
+	return h.array[0]
+}
+
+// PeekMax returns highest priority item without removing it, zero value if heap is empty
+func (h MinMaxHeap[T]) PeekMax() T {
+	if len(h.array) == 0 {
+		var zero T
+		return zero
+	}
+	return h.array[h.maxIndex()]
+}
+
+// remove takes item i out of heap, filling its place with the last item
+func (h *MinMaxHeap[T]) remove(i uint) T {
+	key := h.array[i]
+	last := uint(len(h.array) - 1)
+	h.array[i] = h.array[last]
+	var zero T
+	h.array[last] = zero // don't hold on to removed item
+	h.array = h.array[:last]
+	if i < last {
+		h.trickleDown(i)
+	}
+	return key
+}
+
+// PopMin returns lowest priority item and removes it from heap, zero value if heap is empty
+func (h *MinMaxHeap[T]) PopMin() T {
+	if len(h.array) == 0 {
+		var zero T
+		return zero
+	}
+	return h.remove(0)
+}
+
+// PopMax returns highest priority item and removes it from heap, zero value if heap is empty
+func (h *MinMaxHeap[T]) PopMax() T {
+	if len(h.array) == 0 {
+		var zero T
+		return zero
+	}
+	return h.remove(h.maxIndex())
+}
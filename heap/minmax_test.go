@@ -0,0 +1,62 @@
+// minmax_test.go
+
+package heap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestMinMaxHeap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewMinMax[int](nil)
+	var ref []int // sorted reference items
+
+	for op := 0; op < 5000; op++ {
+		switch r.Intn(4) {
+		case 0, 1:
+			x := r.Intn(500)
+			h.Push(x)
+			ref = append(ref, x)
+			sort.Ints(ref)
+		case 2:
+			if len(ref) > 0 {
+				if x := h.PopMin(); x != ref[0] {
+					t.Fatalf("%d: Invalid PopMin, found: %d, expected: %d", op, x, ref[0])
+				}
+				ref = ref[1:]
+			}
+		case 3:
+			if len(ref) > 0 {
+				if x := h.PopMax(); x != ref[len(ref)-1] {
+					t.Fatalf("%d: Invalid PopMax, found: %d, expected: %d", op, x, ref[len(ref)-1])
+				}
+				ref = ref[:len(ref)-1]
+			}
+		}
+
+		if h.Len() != len(ref) {
+			t.Fatalf("%d: Invalid length, found: %d, expected: %d", op, h.Len(), len(ref))
+		}
+		if len(ref) > 0 && (h.PeekMin() != ref[0] || h.PeekMax() != ref[len(ref)-1]) {
+			t.Fatalf("%d: Invalid peek, found: %d/%d, expected: %d/%d", op, h.PeekMin(), h.PeekMax(), ref[0], ref[len(ref)-1])
+		}
+	}
+
+	// custom priority, lowest string first
+	s := NewMinMax(func(a, b string) bool {
+		return a < b
+	})
+	for _, x := range []string{"kenny", "kyle", "eric", "chef", "stan", "timmy"} {
+		s.Push(x)
+	}
+	if s.PopMax() != "chef" || s.PopMin() != "timmy" || s.PopMin() != "stan" || s.PeekMax() != "eric" {
+		t.Errorf("Invalid string order")
+	}
+
+	var empty MinMaxHeap[int]
+	if empty.PopMin() != 0 || empty.PopMax() != 0 || empty.PeekMax() != 0 {
+		t.Errorf("Empty heap returned items")
+	}
+}
@@ -0,0 +1,175 @@
+// pairing.go
+
+package heap
+
+// PairingNode - item in a PairingHeap, also the handle returned by Insert()
+type PairingNode[T any] struct {
+	value   T
+	child   *PairingNode[T] // first child
+	sibling *PairingNode[T] // next sibling
+	prev    *PairingNode[T] // parent if first child, else previous sibling
+}
+
+// Value - the item held by node
+func (n *PairingNode[T]) Value() T {
+	return n.value
+}
+
+// PairingHeap - pointer based heap, a tree whose root has priority over all
+//  Insert, Meld and DecreaseKey in O(1), ExtractMax in amortized O(log n)
+type PairingHeap[T any] struct {
+	root    *PairingNode[T]
+	greater Prioritize[T]
+	size    int
+}
+
+// NewPairing returns an empty pairing heap prioritized by gt
+//  if gt is nil, default casts items as type int
+func NewPairing[T any](gt Prioritize[T]) *PairingHeap[T] {
+	h := &PairingHeap[T]{}
+	if gt == nil {
+		h.SetGTIntPrioritizeHeapItem()
+	} else {
+		h.SetPrioritizeHeapItem(gt)
+	}
+	return h
+}
+
+// SetPrioritizeHeapItem - "a > b" or whatever comparison is needed
+func (h *PairingHeap[T]) SetPrioritizeHeapItem(a Prioritize[T]) {
+	h.greater = a
+}
+
+// SetGTIntPrioritizeHeapItem - default "a > b" as ints
+func (h *PairingHeap[T]) SetGTIntPrioritizeHeapItem() {
+	h.greater = func(a, b T) bool {
+		return any(a).(int) > any(b).(int)
+	}
+}
+
+// Len returns number of items in heap
+func (h PairingHeap[T]) Len() int {
+	return h.size
+}
+
+// link makes the root with lower priority the first child of the other, returns the new root
+//  a and b must be roots, either may be nil
+func (h *PairingHeap[T]) link(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	} else if b == nil {
+		return a
+	}
+	if h.greater(b.value, a.value) {
+		a, b = b, a
+	}
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	b.prev = a
+	a.child = b
+	return a
+}
+
+// Insert - add new item to heap, returns its node as a handle
+func (h *PairingHeap[T]) Insert(key T) *PairingNode[T] {
+	n := &PairingNode[T]{value: key}
+	h.root = h.link(h.root, n)
+	h.size++
+	return n
+}
+
+// Push - alias for Insert()
+func (h *PairingHeap[T]) Push(key T) *PairingNode[T] {
+	return h.Insert(key)
+}
+
+// PeekMax - returns prioritized item without removing it, zero value if heap is empty
+func (h PairingHeap[T]) PeekMax() T {
+	if h.root == nil {
+		var zero T
+		return zero
+	}
+	return h.root.value
+}
+
+// Peek - alias for PeekMax()
+func (h PairingHeap[T]) Peek() T {
+	return h.PeekMax()
+}
+
+// mergePairs links sibling roots in pairs left to right, then the pairs right to left
+func (h *PairingHeap[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	var pairs []*PairingNode[T]
+	for a := first; a != nil; {
+		b := a.sibling
+		var next *PairingNode[T]
+		if b != nil {
+			next = b.sibling
+			b.sibling, b.prev = nil, nil
+		}
+		a.sibling, a.prev = nil, nil
+		pairs = append(pairs, h.link(a, b))
+		a = next
+	}
+	var root *PairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+	return root
+}
+
+// ExtractMax - returns prioritized item and removes it from heap, zero value if heap is empty
+func (h *PairingHeap[T]) ExtractMax() T {
+	r := h.root
+	if r == nil {
+		var zero T
+		return zero
+	}
+	h.root = h.mergePairs(r.child)
+	r.child = nil
+	h.size--
+	return r.value
+}
+
+// Pop - alias for ExtractMax()
+func (h *PairingHeap[T]) Pop() T {
+	return h.ExtractMax()
+}
+
+// DecreaseKey - moves node n to a key of higher priority, a decrease for min heaps
+//  returns false, leaving n unchanged, if key has lower priority or n is not in heap
+func (h *PairingHeap[T]) DecreaseKey(n *PairingNode[T], key T) bool {
+	if n == nil || (n != h.root && n.prev == nil) || h.greater(n.value, key) {
+		return false
+	}
+	n.value = key
+	if n == h.root {
+		return true
+	}
+	// cut n's branch out and link it back in as a root
+	if n.prev.child == n {
+		n.prev.child = n.sibling
+	} else {
+		n.prev.sibling = n.sibling
+	}
+	if n.sibling != nil {
+		n.sibling.prev = n.prev
+	}
+	n.sibling, n.prev = nil, nil
+	h.root = h.link(h.root, n)
+	return true
+}
+
+// Meld moves all items of other into h in O(1), leaving other empty
+//  handles from other stay valid in h
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == h {
+		return
+	}
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+	other.root = nil
+	other.size = 0
+}
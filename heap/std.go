@@ -0,0 +1,54 @@
+// std.go
+
+package heap
+
+import stdheap "container/heap"
+
+// StdHeap - adapter driving a Heap with the container/heap functions
+//  Less() puts prioritized items first, so stdheap.Pop() returns the same item as ExtractMax()
+type StdHeap[T any] struct {
+	h *Heap[T]
+}
+
+var _ stdheap.Interface = StdHeap[Item]{}
+
+// Std - adapter for using h with container/heap, shares h's array
+func (h *Heap[T]) Std() StdHeap[T] {
+	return StdHeap[T]{h: h}
+}
+
+// Len - logical size of heap
+func (s StdHeap[T]) Len() int {
+	return s.h.Len()
+}
+
+// Less - item a is prioritized above item b
+func (s StdHeap[T]) Less(a, b int) bool {
+	return s.h.greater(s.h.array[a], s.h.array[b])
+}
+
+// Swap - alias for SwapHeapItem() except as signed ints
+func (s StdHeap[T]) Swap(a, b int) {
+	s.h.Swap(a, b)
+}
+
+// Push - adds x at the end without restoring heap order, for stdheap.Push()
+func (s StdHeap[T]) Push(x any) {
+	h := s.h
+	if h.Size() < h.ArraySize() {
+		h.array[h.logicalSize] = x.(T)
+		h.logicalSize++
+	} else {
+		h.SetArray(append(h.array, x.(T)))
+	}
+}
+
+// Pop - removes and returns the last item without restoring heap order, for stdheap.Pop()
+func (s StdHeap[T]) Pop() any {
+	h := s.h
+	if h.logicalSize < 1 {
+		return nil
+	}
+	h.logicalSize--
+	return h.array[h.logicalSize]
+}
@@ -0,0 +1,143 @@
+// std_test.go
+
+package heap
+
+import (
+	stdheap "container/heap"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// intMaxHeap - reference container/heap implementation
+type intMaxHeap []int
+
+func (r intMaxHeap) Len() int           { return len(r) }
+func (r intMaxHeap) Less(a, b int) bool { return r[a] > r[b] }
+func (r intMaxHeap) Swap(a, b int)      { r[a], r[b] = r[b], r[a] }
+func (r *intMaxHeap) Push(x any)        { *r = append(*r, x.(int)) }
+func (r *intMaxHeap) Pop() any {
+	old := *r
+	x := old[len(old)-1]
+	*r = old[:len(old)-1]
+	return x
+}
+
+// checkHeapOrder verifies no item is prioritized above its parent
+func checkHeapOrder[T any](t *testing.T, h *Heap[T]) {
+	for i := uint(1); i < h.Size(); i++ {
+		p, _ := Parent(i)
+		if h.greater(h.array[i], h.array[p]) {
+			t.Fatalf("Item %d prioritized above its parent", i)
+		}
+	}
+}
+
+func TestStdHeap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ref := &intMaxHeap{}
+	h := NewMax[int]()
+	std := h.Std()
+
+	for op := 0; op < 2000; op++ {
+		switch r.Intn(4) {
+		case 0, 1:
+			x := r.Intn(1000)
+			stdheap.Push(ref, x)
+			stdheap.Push(std, x)
+		case 2:
+			if ref.Len() > 0 {
+				if a, b := stdheap.Pop(ref), stdheap.Pop(std); a != b {
+					t.Fatalf("%d: Invalid pop, found: %v, expected: %v", op, b, a)
+				}
+			}
+		case 3:
+			if ref.Len() > 0 {
+				i, x := r.Intn(ref.Len()), r.Intn(1000)
+				(*ref)[i] = x
+				h.array[i] = x
+				stdheap.Fix(ref, i)
+				stdheap.Fix(std, i)
+			}
+		}
+
+		// same algorithm on same data, so same layout
+		if h.Len() != ref.Len() {
+			t.Fatalf("%d: Invalid length, found: %d, expected: %d", op, h.Len(), ref.Len())
+		}
+		for i := range *ref {
+			if h.array[i] != (*ref)[i] {
+				t.Fatalf("%d: Layout differs at %d, found: %d, expected: %d", op, i, h.array[i], (*ref)[i])
+			}
+		}
+	}
+}
+
+func TestHeapFixRemove(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	ref := &intMaxHeap{}
+	h := NewMax[int]()
+
+	for op := 0; op < 2000; op++ {
+		switch r.Intn(5) {
+		case 0, 1:
+			x := r.Intn(1000)
+			stdheap.Push(ref, x)
+			h.Push(x)
+		case 2:
+			if ref.Len() > 0 {
+				if a, b := stdheap.Pop(ref), h.ExtractMax(); a != b {
+					t.Fatalf("%d: Invalid extract, found: %v, expected: %v", op, b, a)
+				}
+			}
+		case 3:
+			// change an item found by value, since layouts may differ
+			if ref.Len() > 0 {
+				i, x := uint(r.Intn(h.Len())), r.Intn(1000)
+				for k := range *ref {
+					if (*ref)[k] == h.array[i] {
+						(*ref)[k] = x
+						stdheap.Fix(ref, k)
+						break
+					}
+				}
+				h.array[i] = x
+				h.Fix(i)
+			}
+		case 4:
+			if ref.Len() > 0 {
+				i := uint(r.Intn(h.Len()))
+				x := h.Remove(i)
+				for k := range *ref {
+					if (*ref)[k] == x {
+						stdheap.Remove(ref, k)
+						break
+					}
+				}
+			}
+		}
+		checkHeapOrder(t, h)
+		if h.Len() != ref.Len() {
+			t.Fatalf("%d: Invalid length, found: %d, expected: %d", op, h.Len(), ref.Len())
+		}
+	}
+
+	// Init after changing all items in place
+	for i := range h.Len() {
+		h.array[i] = r.Intn(1000)
+	}
+	h.Init()
+	checkHeapOrder(t, h)
+
+	// sort.Interface sorts the same way as Sort()
+	items := []Item{23, 13, 55, 10, 6, 99, 22}
+	var sorter BinaryHeap
+	sorter.SetArray(items)
+	sorter.SetGTIntPrioritizeHeapItem()
+	sort.Sort(&sorter)
+	for x := 1; x < len(items); x++ {
+		if items[x-1].(int) > items[x].(int) {
+			t.Errorf("Invalid sort.Sort order: %v", items)
+		}
+	}
+}
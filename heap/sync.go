@@ -0,0 +1,111 @@
+// sync.go
+
+package heap
+
+import (
+	"context"
+	"sync"
+)
+
+// SyncHeap - heap safe for concurrent use
+//  readers share a lock for Peek/Len, writers take it alone for Push/Pop
+type SyncHeap[T any] struct {
+	mu     sync.RWMutex
+	heap   *Heap[T]
+	pushed chan struct{} // closed on Push to wake PopWait, nil if none waiting
+}
+
+// NewSyncHeap wraps h for concurrent use
+//  h must not be used directly afterwards
+func NewSyncHeap[T any](h *Heap[T]) *SyncHeap[T] {
+	return &SyncHeap[T]{heap: h}
+}
+
+// Len returns number of items in heap
+func (s *SyncHeap[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.heap.Len()
+}
+
+// Push adds new item to heap, waking a PopWait
+func (s *SyncHeap[T]) Push(key T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.heap.Push(key)
+	if s.pushed != nil {
+		close(s.pushed)
+		s.pushed = nil
+	}
+}
+
+// Peek returns prioritized item without removing it, false if heap is empty
+func (s *SyncHeap[T]) Peek() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.heap.Size() < 1 {
+		var zero T
+		return zero, false
+	}
+	return s.heap.Peek(), true
+}
+
+// Pop returns prioritized item and removes it from heap, false if heap is empty
+func (s *SyncHeap[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pop()
+}
+
+// pop removes prioritized item, lock must be held
+func (s *SyncHeap[T]) pop() (T, bool) {
+	if s.heap.Size() < 1 {
+		var zero T
+		return zero, false
+	}
+	return s.heap.Pop(), true
+}
+
+// PopWait returns prioritized item and removes it from heap
+//  waits for an item to be pushed if heap is empty, until ctx is done
+func (s *SyncHeap[T]) PopWait(ctx context.Context) (T, error) {
+	for {
+		s.mu.Lock()
+		if key, ok := s.pop(); ok {
+			s.mu.Unlock()
+			return key, nil
+		}
+		if s.pushed == nil {
+			s.pushed = make(chan struct{})
+		}
+		pushed := s.pushed
+		s.mu.Unlock()
+
+		select {
+		case <-pushed:
+			// another waiter may get there first, so check again
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// View calls f with the heap under a read lock
+//  f must not change the heap
+func (s *SyncHeap[T]) View(f func(h *Heap[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f(s.heap)
+}
+
+// Update calls f with the heap under a write lock, waking PopWait if items remain
+func (s *SyncHeap[T]) Update(f func(h *Heap[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s.heap)
+	if s.pushed != nil && s.heap.Size() > 0 {
+		close(s.pushed)
+		s.pushed = nil
+	}
+}
@@ -0,0 +1,81 @@
+// sync_test.go
+
+package heap
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSyncHeap(t *testing.T) {
+	s := NewSyncHeap(NewMax[int]())
+	if _, ok := s.Pop(); ok {
+		t.Errorf("Empty heap popped an item")
+	}
+
+	const pushers, each = 4, 250
+	var wg sync.WaitGroup
+	got := make(chan int, pushers*each)
+
+	// poppers start first, waiting on an empty heap
+	for range pushers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range each {
+				x, err := s.PopWait(context.Background())
+				if err != nil {
+					t.Errorf("PopWait failed: %v", err)
+					return
+				}
+				got <- x
+			}
+		}()
+	}
+	for p := range pushers {
+		go func() {
+			for n := range each {
+				s.Push(p*each + n)
+				s.Peek()
+				s.Len()
+			}
+		}()
+	}
+	wg.Wait()
+	close(got)
+
+	seen := make(map[int]bool)
+	for x := range got {
+		if seen[x] {
+			t.Errorf("Item %d popped twice", x)
+		}
+		seen[x] = true
+	}
+	if len(seen) != pushers*each {
+		t.Errorf("Popped %d items, expected: %d", len(seen), pushers*each)
+	}
+
+	// cancelled wait on empty heap
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := s.PopWait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Invalid PopWait error: %v", err)
+	}
+
+	// items added by Update wake waiters
+	done := make(chan int)
+	go func() {
+		x, _ := s.PopWait(context.Background())
+		done <- x
+	}()
+	time.Sleep(time.Millisecond)
+	s.Update(func(h *Heap[int]) {
+		h.Push(7)
+	})
+	if x := <-done; x != 7 {
+		t.Errorf("Invalid PopWait item, found: %d, expected: 7", x)
+	}
+}
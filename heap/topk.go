@@ -0,0 +1,68 @@
+// topk.go
+
+package heap
+
+// TopK - keeps the k highest priority items of a stream
+//  the lowest priority item retained sits on top of an inner heap, ready to be evicted
+type TopK[T any] struct {
+	heap    Heap[T]
+	greater Prioritize[T]
+	k       uint
+}
+
+// NewTopK returns an empty TopK holding at most k items prioritized by gt
+//  if gt is nil, default casts items as type int
+func NewTopK[T any](k uint, gt Prioritize[T]) *TopK[T] {
+	t := &TopK[T]{k: k}
+	if gt == nil {
+		t.heap.SetGTIntPrioritizeHeapItem()
+		gt = t.heap.greater
+	}
+	t.greater = gt
+	t.heap.SetPrioritizeHeapItem(func(a, b T) bool {
+		return gt(b, a) // lowest priority on top
+	})
+	return t
+}
+
+// K returns the most items kept
+func (t TopK[T]) K() uint {
+	return t.k
+}
+
+// Len returns number of items kept, at most K()
+func (t TopK[T]) Len() int {
+	return t.heap.Len()
+}
+
+// Push offers key, evicting the lowest priority item if full
+//  returns false if key was not kept
+func (t *TopK[T]) Push(key T) bool {
+	if t.heap.Size() < t.k {
+		t.heap.Push(key)
+		return true
+	}
+	if t.k == 0 || !t.greater(key, t.heap.Peek()) {
+		return false
+	}
+	t.heap.ReplaceItem(0, key)
+	return true
+}
+
+// Lowest returns lowest priority item kept, which the next Push must beat when full
+//  false if none kept
+func (t TopK[T]) Lowest() (T, bool) {
+	if t.heap.Size() < 1 {
+		var zero T
+		return zero, false
+	}
+	return t.heap.Peek(), true
+}
+
+// Sorted returns a copy of items kept, highest priority first
+func (t TopK[T]) Sorted() []T {
+	items := make([]T, t.heap.Size())
+	copy(items, t.heap.array)
+	var sorter Heap[T]
+	return sorter.Sort(items, t.heap.greater) // inner order is reversed, so sorts high to low
+}
@@ -0,0 +1,67 @@
+// topk_test.go
+
+package heap
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	stream := make([]int, 1000)
+	for x := range stream {
+		stream[x] = r.Intn(500)
+	}
+
+	tests := []struct {
+		givenK uint
+	}{
+		{0}, {1}, {10}, {999}, {1000}, {2000},
+	}
+
+	for i, test := range tests {
+		top := NewTopK[int](test.givenK, nil)
+		for _, x := range stream {
+			top.Push(x)
+			if uint(top.Len()) > test.givenK {
+				t.Fatalf("%d: Kept %d items, expected at most: %d", i, top.Len(), test.givenK)
+			}
+		}
+
+		want := append([]int(nil), stream...)
+		sort.Sort(sort.Reverse(sort.IntSlice(want)))
+		want = want[:min(int(test.givenK), len(want))]
+
+		sorted := top.Sorted()
+		if len(sorted) != len(want) {
+			t.Errorf("%d: Invalid length, found: %d, expected: %d", i, len(sorted), len(want))
+			continue
+		}
+		for x := range want {
+			if sorted[x] != want[x] {
+				t.Errorf("%d: Invalid order, found: %v, expected: %v", i, sorted[x], want[x])
+				break
+			}
+		}
+		if low, ok := top.Lowest(); ok != (len(want) > 0) || (ok && low != want[len(want)-1]) {
+			t.Errorf("%d: Invalid lowest, found: %d", i, low)
+		}
+	}
+
+	// custom priority, shortest strings first
+	short := NewTopK(2, func(a, b string) bool {
+		return len(a) < len(b)
+	})
+	for _, s := range strings.Fields("kenny kyle eric chef stan timmy butters") {
+		short.Push(s)
+	}
+	if got := short.Sorted(); len(got) != 2 || len(got[0]) != 4 || len(got[1]) != 4 {
+		t.Errorf("Invalid custom priority result: %v", got)
+	}
+	if short.Push("cartman") {
+		t.Errorf("Kept an item of lowest priority")
+	}
+}
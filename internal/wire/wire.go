@@ -0,0 +1,151 @@
+// wire.go
+
+// Package wire is the binary format shared by MarshalBinary of the heaps and trees
+//  a header of magic, format version and kind of structure, then uvarints and length prefixed items
+package wire
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/PuppyKhan/jebe/collection"
+)
+
+// Version of the format written, readers reject any other
+const Version = 1
+
+// Kind - which structure is encoded
+type Kind byte
+
+// Kinds of structure
+const (
+	Heap Kind = iota + 1
+	BST
+	AVL
+)
+
+// magic starts every encoding
+const magic = "jebe"
+
+// ErrCorrupt - data is truncated or malformed
+var ErrCorrupt = errors.New("jebe: corrupt binary data")
+
+// Encoder appends an encoding to a byte slice
+type Encoder[T any] struct {
+	buf   []byte
+	codec collection.Codec[T]
+}
+
+// NewEncoder starts an encoding of kind with the header, using GobCodec() if codec is unset
+func NewEncoder[T any](kind Kind, codec collection.Codec[T]) *Encoder[T] {
+	if codec.Encode == nil {
+		codec = collection.GobCodec[T]()
+	}
+	buf := append([]byte(magic), Version, byte(kind))
+	return &Encoder[T]{buf: buf, codec: codec}
+}
+
+// Uint appends n
+func (e *Encoder[T]) Uint(n uint64) {
+	e.buf = binary.AppendUvarint(e.buf, n)
+}
+
+// Byte appends b
+func (e *Encoder[T]) Byte(b byte) {
+	e.buf = append(e.buf, b)
+}
+
+// Item appends item, length prefixed
+func (e *Encoder[T]) Item(item T) error {
+	data, err := e.codec.Encode(item)
+	if err != nil {
+		return err
+	}
+	e.Uint(uint64(len(data)))
+	e.buf = append(e.buf, data...)
+	return nil
+}
+
+// Bytes returns the encoding
+func (e *Encoder[T]) Bytes() []byte {
+	return e.buf
+}
+
+// Decoder reads an encoding from a byte slice
+type Decoder[T any] struct {
+	data  []byte
+	codec collection.Codec[T]
+}
+
+// NewDecoder checks header of data is for kind and a known version, using GobCodec() if codec is unset
+func NewDecoder[T any](data []byte, kind Kind, codec collection.Codec[T]) (*Decoder[T], error) {
+	if codec.Decode == nil {
+		codec = collection.GobCodec[T]()
+	}
+	if len(data) < len(magic)+2 || string(data[:len(magic)]) != magic {
+		return nil, ErrCorrupt
+	}
+	if v := data[len(magic)]; v != Version {
+		return nil, fmt.Errorf("jebe: unsupported binary version %d", v)
+	}
+	if k := Kind(data[len(magic)+1]); k != kind {
+		return nil, fmt.Errorf("jebe: binary data is of kind %d, expected %d", k, kind)
+	}
+	return &Decoder[T]{data: data[len(magic)+2:], codec: codec}, nil
+}
+
+// Uint reads a uvarint
+func (d *Decoder[T]) Uint() (uint64, error) {
+	n, l := binary.Uvarint(d.data)
+	if l <= 0 {
+		return 0, ErrCorrupt
+	}
+	d.data = d.data[l:]
+	return n, nil
+}
+
+// Len reads a uvarint count of things, each taking at least min bytes, so a corrupt count can't allocate too much
+func (d *Decoder[T]) Len(min int) (int, error) {
+	n, err := d.Uint()
+	if err != nil {
+		return 0, err
+	}
+	if n > uint64(len(d.data)/min) {
+		return 0, ErrCorrupt
+	}
+	return int(n), nil
+}
+
+// Byte reads a byte
+func (d *Decoder[T]) Byte() (byte, error) {
+	if len(d.data) == 0 {
+		return 0, ErrCorrupt
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b, nil
+}
+
+// Item reads a length prefixed item
+func (d *Decoder[T]) Item() (T, error) {
+	var zero T
+	n, err := d.Uint()
+	if err != nil {
+		return zero, err
+	}
+	if n > uint64(len(d.data)) {
+		return zero, ErrCorrupt
+	}
+	item, err := d.codec.Decode(d.data[:n])
+	d.data = d.data[n:]
+	return item, err
+}
+
+// Done checks all data was read
+func (d *Decoder[T]) Done() error {
+	if len(d.data) != 0 {
+		return ErrCorrupt
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// iter.go
+
+package rbtree
+
+import "iter"
+
+// All iterates over items in order
+func (t Tree[T]) All() iter.Seq[T] {
+	return t.ascendFrom(GetMinimum(t.root))
+}
+
+// Backward iterates over items in reverse order
+func (t Tree[T]) Backward() iter.Seq[T] {
+	return t.descendFrom(GetMaximum(t.root))
+}
+
+// ascendFrom iterates in order starting at node n
+func (t Tree[T]) ascendFrom(n *TreeNode[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := n; x != nil; x = t.GetNext(x) {
+			if !yield(x.value) {
+				return
+			}
+		}
+	}
+}
+
+// descendFrom iterates in reverse order starting at node n
+func (t Tree[T]) descendFrom(n *TreeNode[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for x := n; x != nil; x = t.GetPrevious(x) {
+			if !yield(x.value) {
+				return
+			}
+		}
+	}
+}
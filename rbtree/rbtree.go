@@ -0,0 +1,657 @@
+// rbtree.go
+
+package rbtree
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/PuppyKhan/jebe/collection"
+)
+
+// Item - the type to be sorted
+type Item = collection.Item
+
+// TreeNode of a red-black tree holding items of type T
+//  nil children are the black leaves
+type TreeNode[T any] struct {
+	value  T
+	left   *TreeNode[T]
+	right  *TreeNode[T]
+	parent *TreeNode[T] // doubly linked
+	red    bool         // for red-black properties, false is black
+}
+
+// Node of a red-black tree of Items
+type Node = TreeNode[Item]
+
+// Prioritize - custom comparison for prioritizing tree items of type T
+//  basic sort would need "a < b" ("a > b" for high to low)
+type Prioritize[T any] = collection.Prioritize[T]
+
+// Equivalence - custom comparison for equality of tree items of type T, "a == b"
+type Equivalence[T any] = collection.Equivalence[T]
+
+// Comparison - custom three-way comparison of tree items of type T
+//  negative for "a < b", 0 for "a == b", positive for "a > b", like cmp.Compare
+type Comparison[T any] = collection.Comparison[T]
+
+// PrioritizeTreeItem - custom comparison for prioritizing tree items
+//  basic sort would need "a < b" ("a > b" for high to low)
+type PrioritizeTreeItem = Prioritize[Item]
+
+// EquivalenceTreeItem - custom comparison for equality of tree items, "a == b"
+//  needed for search
+type EquivalenceTreeItem = Equivalence[Item]
+
+// CompareTreeItem - custom three-way comparison of tree items
+//  replaces a PrioritizeTreeItem and EquivalenceTreeItem pair
+type CompareTreeItem = Comparison[Item]
+
+// Tree holds the root of the tree and its comparison functions, for items of type T
+type Tree[T any] struct {
+	root    *TreeNode[T]
+	lesser  Prioritize[T]
+	equals  Equivalence[T]
+	compare Comparison[T] // used for all ordering, derived if not set directly
+	size    int
+}
+
+// BinaryTree - tree of Items, the interface{} API over the generic Tree
+type BinaryTree = Tree[Item]
+
+// New returns an empty tree using the given comparison funcs
+//  if either is nil, default casts items as type int
+func New[T any](a Prioritize[T], b Equivalence[T]) *Tree[T] {
+	t := &Tree[T]{}
+	t.setComparisons(a, b)
+	return t
+}
+
+// NewCompare returns an empty tree using a three-way comparison func
+//  if c is nil, default casts items as type int
+func NewCompare[T any](c Comparison[T]) *Tree[T] {
+	t := &Tree[T]{}
+	t.setCompare(c)
+	return t
+}
+
+// NewOrdered returns an empty tree of ordered items using cmp.Compare
+func NewOrdered[T cmp.Ordered]() *Tree[T] {
+	return NewCompare(cmp.Compare[T])
+}
+
+// MakeNode puts Item into a red Node, sets parent, returns pointer
+func MakeNode[T any](val T, p *TreeNode[T]) *TreeNode[T] {
+	return &TreeNode[T]{
+		value:  val,
+		left:   nil,
+		right:  nil,
+		parent: p,
+		red:    true,
+	}
+}
+
+// Init sets both root node and comparison func
+func (t *Tree[T]) Init(root T, a Prioritize[T], b Equivalence[T]) {
+	t.setComparisons(a, b)
+
+	// Insert() uses compare() methods, so setComparisons() must run first
+	t.Insert(root)
+}
+
+// InitCompare sets both root node and three-way comparison func
+func (t *Tree[T]) InitCompare(root T, c Comparison[T]) {
+	t.setCompare(c)
+
+	// Insert() uses compare() methods, so setCompare() must run first
+	t.Insert(root)
+}
+
+// setCompare sets three-way comparison func, defaulting nil to ints
+func (t *Tree[T]) setCompare(c Comparison[T]) {
+	if c == nil {
+		t.setComparisons(nil, nil)
+	} else {
+		t.SetCompareTreeItem(c)
+	}
+}
+
+// setComparisons sets both comparison funcs, defaulting nil ones to ints
+func (t *Tree[T]) setComparisons(a Prioritize[T], b Equivalence[T]) {
+	if a == nil {
+		t.SetLTIntPrioritizeTreeItem()
+	} else {
+		t.SetPrioritizeTreeItem(a)
+	}
+	if b == nil {
+		t.SetEqIntEquivalenceTreeItem()
+	} else {
+		t.SetEquivalenceTreeItem(b)
+	}
+}
+
+// SetPrioritizeTreeItem - "a < b" or whatever comparison is needed
+func (t *Tree[T]) SetPrioritizeTreeItem(a Prioritize[T]) {
+	t.lesser = a
+	t.deriveCompare()
+}
+
+// SetLTIntPrioritizeTreeItem - default "a < b" as ints
+func (t *Tree[T]) SetLTIntPrioritizeTreeItem() {
+	t.lesser = func(a, b T) bool {
+		return any(a).(int) < any(b).(int)
+	}
+	t.deriveCompare()
+}
+
+// SetEquivalenceTreeItem - "a == b" or whatever comparison is needed
+func (t *Tree[T]) SetEquivalenceTreeItem(b Equivalence[T]) {
+	t.equals = b
+	t.deriveCompare()
+}
+
+// SetEqIntEquivalenceTreeItem - default "a == b" as ints
+func (t *Tree[T]) SetEqIntEquivalenceTreeItem() {
+	t.equals = func(a, b T) bool {
+		return any(a).(int) == any(b).(int)
+	}
+	t.deriveCompare()
+}
+
+// SetCompareTreeItem - three-way comparison such as cmp.Compare or strings.Compare
+//  also derives lesser and equals from it
+func (t *Tree[T]) SetCompareTreeItem(c Comparison[T]) {
+	t.compare = c
+	t.lesser = func(a, b T) bool {
+		return c(a, b) < 0
+	}
+	t.equals = func(a, b T) bool {
+		return c(a, b) == 0
+	}
+}
+
+// deriveCompare adapts lesser and equals into a three-way comparison
+func (t *Tree[T]) deriveCompare() {
+	lesser, equals := t.lesser, t.equals
+	if equals == nil { // only lesser set, items are equal when neither is less
+		equals = func(a, b T) bool {
+			return !lesser(a, b) && !lesser(b, a)
+		}
+	}
+	t.compare = func(a, b T) int {
+		if lesser(a, b) {
+			return -1
+		} else if equals(a, b) {
+			return 0
+		}
+		return 1
+	}
+}
+
+// isRed - nil leaves are black
+func isRed[T any](n *TreeNode[T]) bool {
+	return n != nil && n.red
+}
+
+// LeftRotate rotates a node with its right child, updating root if needed
+func (t *Tree[T]) LeftRotate(x *TreeNode[T]) {
+	if x == nil || x.right == nil {
+		return // can't rotate left
+	}
+	y := x.right
+	x.right = y.left
+	if y.left != nil {
+		y.left.parent = x
+	}
+	y.parent = x.parent
+	if x.parent == nil {
+		t.root = y
+	} else if x == x.parent.left {
+		x.parent.left = y
+	} else {
+		x.parent.right = y
+	}
+	y.left = x
+	x.parent = y
+}
+
+// RightRotate rotates a node with its left child, updating root if needed
+func (t *Tree[T]) RightRotate(x *TreeNode[T]) {
+	if x == nil || x.left == nil {
+		return // can't rotate right
+	}
+	y := x.left
+	x.left = y.right
+	if y.right != nil {
+		y.right.parent = x
+	}
+	y.parent = x.parent
+	if x.parent == nil {
+		t.root = y
+	} else if x == x.parent.right {
+		x.parent.right = y
+	} else {
+		x.parent.left = y
+	}
+	y.right = x
+	x.parent = y
+}
+
+// Insert a new Item to a tree
+func (t *Tree[T]) Insert(newValue T) {
+	var y *TreeNode[T]
+	x := t.root
+	z := MakeNode(newValue, nil)
+	less := false
+	for x != nil {
+		y = x
+		less = t.compare(z.value, x.value) < 0
+		if less {
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	z.parent = y
+	if y == nil {
+		t.root = z // tree was empty
+	} else if less {
+		y.left = z
+	} else {
+		y.right = z
+	}
+	t.size++
+
+	// now fix red-black properties on inserted node & upwards
+	t.insertFixup(z)
+}
+
+// insertFixup recolors and rotates until red z has no red parent
+func (t *Tree[T]) insertFixup(z *TreeNode[T]) {
+	for isRed(z.parent) {
+		p := z.parent
+		g := p.parent // exists, since red p can't be root
+		if p == g.left {
+			if y := g.right; isRed(y) { // red uncle, recolor and move up
+				p.red = false
+				y.red = false
+				g.red = true
+				z = g
+			} else {
+				if z == p.right { // inner child, rotate to outer
+					z = p
+					t.LeftRotate(z)
+					p = z.parent
+				}
+				p.red = false
+				g.red = true
+				t.RightRotate(g)
+			}
+		} else {
+			if y := g.left; isRed(y) { // red uncle, recolor and move up
+				p.red = false
+				y.red = false
+				g.red = true
+				z = g
+			} else {
+				if z == p.left { // inner child, rotate to outer
+					z = p
+					t.RightRotate(z)
+					p = z.parent
+				}
+				p.red = false
+				g.red = true
+				t.LeftRotate(g)
+			}
+		}
+	}
+	t.root.red = false
+}
+
+// GetRoot helper for treewalk
+func (t Tree[T]) GetRoot() *TreeNode[T] {
+	return t.root
+}
+
+// Len returns number of items in tree
+func (t Tree[T]) Len() int {
+	return t.size
+}
+
+// InOrderTreeWalk does left, current, right
+//  follows links rather than comparing items, so equal items are all sent
+//  closes channel when done
+func (t Tree[T]) InOrderTreeWalk(n *TreeNode[T], c chan T) {
+	if n != nil {
+		stop := n.parent
+		last, x := stop, n // last is node x was reached from
+		for x != stop {
+			var next *TreeNode[T]
+			if last == x.parent && x.left != nil { // came down, go left first
+				next = x.left
+			} else if last == x.right && x.right != nil { // came up from right, done here
+				next = x.parent
+			} else { // left side done
+				c <- x.value
+				if x.right != nil {
+					next = x.right
+				} else {
+					next = x.parent
+				}
+			}
+			last, x = x, next
+		}
+	}
+	close(c)
+}
+
+// Search to find node with Item in current branch or nil if none
+func (t Tree[T]) Search(k T, current *TreeNode[T]) *TreeNode[T] {
+	x := current
+	if x == nil {
+		x = t.root
+	}
+	for x != nil {
+		c := t.compare(k, x.value)
+		if c == 0 {
+			break
+		} else if c < 0 {
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	return x
+}
+
+// GetMinimum finds lowest value
+func GetMinimum[T any](current *TreeNode[T]) *TreeNode[T] {
+	x := current
+	for x != nil && x.left != nil {
+		x = x.left
+	}
+	return x
+}
+
+// GetTreeMinimum finds lowest value of tree
+func (t Tree[T]) GetTreeMinimum() *TreeNode[T] {
+	return GetMinimum(t.root)
+}
+
+// PopTreeMinimum removes lowest value of tree, nil if tree is empty
+func (t *Tree[T]) PopTreeMinimum() *T {
+	n := GetMinimum(t.root)
+	if n == nil {
+		return nil
+	}
+	t.Delete(n)
+	return &n.value
+}
+
+// GetMaximum finds highest value
+func GetMaximum[T any](current *TreeNode[T]) *TreeNode[T] {
+	x := current
+	for x != nil && x.right != nil {
+		x = x.right
+	}
+	return x
+}
+
+// GetTreeMaximum finds highest value of tree
+func (t Tree[T]) GetTreeMaximum() *TreeNode[T] {
+	return GetMaximum(t.root)
+}
+
+// PopTreeMaximum removes highest value of tree, nil if tree is empty
+func (t *Tree[T]) PopTreeMaximum() *T {
+	n := GetMaximum(t.root)
+	if n == nil {
+		return nil
+	}
+	t.Delete(n)
+	return &n.value
+}
+
+// GetNext finds successor in order
+func (t Tree[T]) GetNext(current *TreeNode[T]) *TreeNode[T] {
+	x := current
+	if x == nil {
+		return nil
+	}
+	if x.right != nil {
+		return GetMinimum(x.right)
+	}
+	y := x.parent
+	for y != nil && x == y.right {
+		x = y
+		y = y.parent
+	}
+	return y
+}
+
+// GetPrevious finds predecessor in order
+func (t Tree[T]) GetPrevious(current *TreeNode[T]) *TreeNode[T] {
+	x := current
+	if x == nil {
+		return nil
+	}
+	if x.left != nil {
+		return GetMaximum(x.left)
+	}
+	y := x.parent
+	for y != nil && x == y.left {
+		x = y
+		y = y.parent
+	}
+	return y
+}
+
+// Transplant switches branch u with v
+//  Updates v's parent link
+//  Does not update u, sub branches, colors, etc
+//  u must exist, v may be nil
+func (t *Tree[T]) Transplant(u, v *TreeNode[T]) {
+	if u == nil {
+		return // u must exist
+	}
+	if u.parent == nil { // only tree root has no parent
+		t.root = v
+	} else if u == u.parent.left {
+		u.parent.left = v
+	} else {
+		u.parent.right = v
+	}
+	if v != nil {
+		v.parent = u.parent
+	}
+}
+
+// Delete removes a node and adjusts tree accordingly
+func (t *Tree[T]) Delete(z *TreeNode[T]) {
+	if z == nil {
+		return
+	}
+	// x moves into y's place, y being z or its successor, xParent tracked since x may be nil
+	var x, xParent *TreeNode[T]
+	yRed := z.red
+	if z.left == nil { // no or only right child
+		x = z.right
+		xParent = z.parent
+		t.Transplant(z, z.right)
+	} else if z.right == nil { // only left child
+		x = z.left
+		xParent = z.parent
+		t.Transplant(z, z.left)
+	} else { // both children present
+		y := GetMinimum(z.right)
+		yRed = y.red
+		x = y.right
+		if y.parent == z {
+			xParent = y
+		} else {
+			xParent = y.parent
+			t.Transplant(y, y.right)
+			y.right = z.right
+			y.right.parent = y
+		}
+		t.Transplant(z, y)
+		y.left = z.left
+		y.left.parent = y
+		y.red = z.red
+	}
+	t.size--
+
+	// removing a black node shortens its paths, so fix black heights
+	if !yRed {
+		t.deleteFixup(x, xParent)
+	}
+
+	// clean up z
+	z.left = nil
+	z.right = nil
+	z.parent = nil
+}
+
+// deleteFixup pushes the extra black on x up the tree until it can be absorbed
+func (t *Tree[T]) deleteFixup(x, parent *TreeNode[T]) {
+	for x != t.root && !isRed(x) {
+		if x == parent.left {
+			w := parent.right // sibling, exists since x's side is short a black
+			if isRed(w) {
+				w.red = false
+				parent.red = true
+				t.LeftRotate(parent)
+				w = parent.right
+			}
+			if !isRed(w.left) && !isRed(w.right) {
+				w.red = true
+				x = parent
+				parent = x.parent
+			} else {
+				if !isRed(w.right) {
+					w.left.red = false
+					w.red = true
+					t.RightRotate(w)
+					w = parent.right
+				}
+				w.red = parent.red
+				parent.red = false
+				w.right.red = false
+				t.LeftRotate(parent)
+				x = t.root
+			}
+		} else {
+			w := parent.left // sibling, exists since x's side is short a black
+			if isRed(w) {
+				w.red = false
+				parent.red = true
+				t.RightRotate(parent)
+				w = parent.left
+			}
+			if !isRed(w.right) && !isRed(w.left) {
+				w.red = true
+				x = parent
+				parent = x.parent
+			} else {
+				if !isRed(w.left) {
+					w.right.red = false
+					w.red = true
+					t.LeftRotate(w)
+					w = parent.left
+				}
+				w.red = parent.red
+				parent.red = false
+				w.left.red = false
+				t.RightRotate(parent)
+				x = t.root
+			}
+		}
+	}
+	if x != nil {
+		x.red = false
+	}
+}
+
+// Validate checks ordering, parent links and the red-black properties:
+//  root is black, a red node has no red child, and every path down has the same number of black nodes
+//  returns an error naming the first offending node, nil if tree is valid
+func (t Tree[T]) Validate() error {
+	if t.root == nil {
+		return nil
+	}
+	if t.root.parent != nil {
+		return fmt.Errorf("root %v has a parent", t.root.value)
+	}
+	if t.root.red {
+		return fmt.Errorf("root %v is red", t.root.value)
+	}
+	var last *TreeNode[T]
+	_, err := t.validate(t.root, &last)
+	return err
+}
+
+// validate checks branch n in order, last being the node visited before it
+//  returns black height of n
+func (t Tree[T]) validate(n *TreeNode[T], last **TreeNode[T]) (int, error) {
+	if n == nil {
+		return 1, nil
+	}
+	for _, c := range []*TreeNode[T]{n.left, n.right} {
+		if c == nil {
+			continue
+		}
+		if c.parent != n {
+			return 0, fmt.Errorf("node %v has wrong parent link", c.value)
+		}
+		if n.red && c.red {
+			return 0, fmt.Errorf("red node %v has red child %v", n.value, c.value)
+		}
+	}
+	l, err := t.validate(n.left, last)
+	if err != nil {
+		return 0, err
+	}
+	if *last != nil && t.compare((*last).value, n.value) > 0 {
+		return 0, fmt.Errorf("node %v is out of order after %v", n.value, (*last).value)
+	}
+	*last = n
+	r, err := t.validate(n.right, last)
+	if err != nil {
+		return 0, err
+	}
+	if l != r {
+		return 0, fmt.Errorf("node %v has black height %d on left, %d on right", n.value, l, r)
+	}
+	if !n.red {
+		l++
+	}
+	return l, nil
+}
+
+// Using red-black tree as a priority queue
+
+// Push - alias for Insert()
+func (t *Tree[T]) Push(key T) {
+	t.Insert(key)
+}
+
+// Peek - value of GetTreeMinimum(), zero value if tree is empty
+func (t Tree[T]) Peek() T {
+	n := t.GetTreeMinimum()
+	if n == nil {
+		var zero T
+		return zero
+	}
+	return n.value
+}
+
+// Pop - value of PopTreeMinimum(), zero value if tree is empty
+func (t *Tree[T]) Pop() T {
+	v := t.PopTreeMinimum()
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,242 @@
+// rbtree_test.go
+
+package rbtree
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRedBlackTreeWalk(t *testing.T) {
+	tests := []struct {
+		givenArray        []Item
+		givenPriorityFunc func(a, b Item) bool
+		givenEqualityFunc func(a, b Item) bool
+		givenDeletables   []Item
+
+		wantArray      []Item
+		wantFinalArray []Item
+	}{
+		{
+			[]Item{},
+			nil, // default int sort
+			nil,
+			[]Item{},
+
+			[]Item{},
+			[]Item{},
+		},
+		{
+			[]Item{5, 2, 9, 7, 1, 3, 4, 24, 14, 34, -1, 12, 18, 10, 16},
+			nil, // default int sort
+			nil,
+			[]Item{24, -1, 5},
+
+			[]Item{-1, 1, 2, 3, 4, 5, 7, 9, 10, 12, 14, 16, 18, 24, 34},
+			[]Item{1, 2, 3, 4, 7, 9, 10, 12, 14, 16, 18, 34},
+		},
+		{
+			[]Item{"kenny", "kyle", "eric", "chef", "stan", "timmy"},
+			func(a, b Item) bool {
+				return (strings.Compare(a.(string), b.(string)) < 0)
+			},
+			func(a, b Item) bool {
+				return (strings.Compare(a.(string), b.(string)) == 0)
+			},
+			[]Item{"timmy"},
+
+			[]Item{"chef", "eric", "kenny", "kyle", "stan", "timmy"},
+			[]Item{"chef", "eric", "kenny", "kyle", "stan"},
+		},
+		{
+			[]Item{5, 5, 5, 3, 5, 7, 5},
+			nil, // default int sort
+			nil,
+			[]Item{5},
+
+			[]Item{3, 5, 5, 5, 5, 5, 7},
+			[]Item{3, 5, 5, 5, 5, 7},
+		},
+	}
+
+	for i, test := range tests {
+		var tree BinaryTree
+
+		for x, n := range test.givenArray {
+			if x == 0 {
+				tree.Init(n, test.givenPriorityFunc, test.givenEqualityFunc)
+			} else {
+				tree.Insert(n)
+			}
+		}
+
+		ch1 := make(chan Item, 1)
+		go tree.InOrderTreeWalk(tree.GetRoot(), ch1)
+
+		x := 0
+		for y := range ch1 {
+			if !tree.equals(y, test.wantArray[x]) {
+				t.Errorf("%d: Invalid order, found: %v, expected: %v", i, y, test.wantArray[x])
+				return
+			}
+			x++
+		}
+
+		for _, n := range test.givenDeletables {
+			tree.Delete(tree.Search(n, nil))
+			if err := tree.Validate(); err != nil {
+				t.Errorf("%d: %v", i, err)
+			}
+		}
+
+		ch2 := make(chan Item, 1)
+		go tree.InOrderTreeWalk(tree.GetRoot(), ch2)
+
+		x = 0
+		for y := range ch2 {
+			if !tree.equals(y, test.wantFinalArray[x]) {
+				t.Errorf("%d: Invalid order, found: %v, expected: %v", i, y, test.wantFinalArray[x])
+				return
+			}
+			x++
+		}
+		if x != len(test.wantFinalArray) || tree.Len() != x {
+			t.Errorf("%d: Walked %d items, length %d, expected: %d", i, x, tree.Len(), len(test.wantFinalArray))
+		}
+	}
+}
+
+func TestRedBlackBalance(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		tree := NewOrdered[int]()
+		present := map[int]bool{}
+		for i := 0; i < 80; i++ {
+			if n := r.Intn(100); !present[n] {
+				present[n] = true
+				tree.Insert(n)
+				if err := tree.Validate(); err != nil {
+					t.Fatalf("%d: insert %d: %v", trial, n, err)
+				}
+			}
+		}
+		for i := 0; i < 80; i++ {
+			if n := r.Intn(100); present[n] {
+				delete(present, n)
+				tree.Delete(tree.Search(n, nil))
+				if err := tree.Validate(); err != nil {
+					t.Fatalf("%d: delete %d: %v", trial, n, err)
+				}
+			}
+		}
+
+		x := 0
+		for n := tree.GetTreeMinimum(); n != nil; n = tree.GetNext(n) {
+			if !present[n.value] {
+				t.Fatalf("%d: Found deleted item %d", trial, n.value)
+			}
+			x++
+		}
+		if x != len(present) || tree.Len() != x {
+			t.Fatalf("%d: Walked %d items, length %d, expected: %d", trial, x, tree.Len(), len(present))
+		}
+
+		x = 0
+		for n := tree.GetTreeMaximum(); n != nil; n = tree.GetPrevious(n) {
+			x++
+		}
+		if x != len(present) {
+			t.Fatalf("%d: Walked back %d items, expected: %d", trial, x, len(present))
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tree := NewOrdered[int]()
+	for _, n := range []int{5, 2, 9, 7, 1} {
+		tree.Insert(n)
+	}
+	if err := tree.Validate(); err != nil {
+		t.Fatalf("Valid tree failed: %v", err)
+	}
+
+	tree.root.red = true
+	if tree.Validate() == nil {
+		t.Errorf("Red root not reported")
+	}
+	tree.root.red = false
+
+	n := tree.Search(7, nil)
+	n.red = true
+	n.parent.red = true
+	if tree.Validate() == nil {
+		t.Errorf("Red red violation not reported")
+	}
+	n.parent.red = false
+
+	n.red = false
+	if tree.Validate() == nil {
+		t.Errorf("Black height violation not reported")
+	}
+	n.red = true
+
+	n.value = 99
+	if tree.Validate() == nil {
+		t.Errorf("Order violation not reported")
+	}
+
+	// in order below its parent 9, but not its grandparent 5
+	n.value = 4
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "out of order") {
+		t.Errorf("Order violation against ancestor not reported, found: %v", err)
+	}
+}
+
+func TestRedBlackPriorityQueue(t *testing.T) {
+	tree := NewCompare(func(a, b string) int {
+		return strings.Compare(b, a) // reverse sort
+	})
+	for _, s := range []string{"kenny", "kyle", "eric", "chef", "stan", "timmy"} {
+		tree.Push(s)
+	}
+	for _, want := range []string{"timmy", "stan", "kyle"} {
+		if tree.Peek() != want || tree.Pop() != want {
+			t.Errorf("Invalid priority queue order, expected: %s", want)
+		}
+	}
+	if tree.Len() != 3 {
+		t.Errorf("Invalid length, found: %d, expected: 3", tree.Len())
+	}
+	if m := tree.PopTreeMaximum(); m == nil || *m != "chef" {
+		t.Errorf("Invalid maximum")
+	}
+	tree.Pop()
+	tree.Pop()
+	if tree.Pop() != "" || tree.PopTreeMinimum() != nil || tree.Peek() != "" {
+		t.Errorf("Empty tree has items")
+	}
+}
+
+func TestLesserOnlyTree(t *testing.T) {
+	var tree BinaryTree
+	tree.SetPrioritizeTreeItem(func(a, b Item) bool {
+		return a.(int) < b.(int)
+	})
+	for _, n := range []Item{5, 2, 9, 2, 7} {
+		tree.Insert(n)
+	}
+	ch := make(chan Item, 1)
+	go tree.InOrderTreeWalk(tree.GetRoot(), ch)
+	var got []Item
+	for y := range ch {
+		got = append(got, y)
+	}
+	if want := []Item{2, 2, 5, 7, 9}; !slices.Equal(got, want) {
+		t.Errorf("Invalid order, found: %v, expected: %v", got, want)
+	}
+	if n := tree.Search(7, nil); n == nil || n.value != 7 {
+		t.Errorf("Invalid search, found: %v", n)
+	}
+}
@@ -0,0 +1,77 @@
+// set.go
+
+package rbtree
+
+import "github.com/PuppyKhan/jebe/collection"
+
+// Tree of Items is a collection.SortedSet
+var _ collection.SortedSet = (*BinaryTree)(nil)
+
+// Remove deletes an item equal to k, returns false if none
+func (t *Tree[T]) Remove(k T) bool {
+	n := t.Search(k, nil)
+	if n == nil {
+		return false
+	}
+	t.Delete(n)
+	return true
+}
+
+// Contains reports whether an item equal to k is in tree
+func (t Tree[T]) Contains(k T) bool {
+	return t.Search(k, nil) != nil
+}
+
+// Min returns lowest item, false if tree is empty
+func (t Tree[T]) Min() (T, bool) {
+	return valueOf(t.GetTreeMinimum())
+}
+
+// Max returns highest item, false if tree is empty
+func (t Tree[T]) Max() (T, bool) {
+	return valueOf(t.GetTreeMaximum())
+}
+
+// Count returns number of items equal to k
+func (t Tree[T]) Count(k T) int {
+	count := 0
+	for x := t.lowerBound(k); x != nil && t.compare(x.value, k) == 0; x = t.GetNext(x) {
+		count++
+	}
+	return count
+}
+
+// TryPeek returns lowest item without removing it, false if tree is empty
+func (t Tree[T]) TryPeek() (T, bool) {
+	return t.Min()
+}
+
+// TryPop returns lowest item and removes it from tree, false if tree is empty
+func (t *Tree[T]) TryPop() (T, bool) {
+	n := t.GetTreeMinimum()
+	t.Delete(n)
+	return valueOf(n)
+}
+
+// lowerBound finds first node with item not less than k, or nil if none
+func (t Tree[T]) lowerBound(k T) *TreeNode[T] {
+	var found *TreeNode[T]
+	for x := t.root; x != nil; {
+		if t.compare(x.value, k) >= 0 {
+			found = x
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	return found
+}
+
+// valueOf returns item of node, false if node is nil
+func valueOf[T any](n *TreeNode[T]) (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.value, true
+}